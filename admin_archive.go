@@ -1,8 +1,10 @@
 package main
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/gorilla/mux"
 )
@@ -14,10 +16,54 @@ func handleAdminArchive(w http.ResponseWriter, req *http.Request, page *pageData
 	if id == "archive-current" {
 		// Archive the current gamejam
 		if err := m.ArchiveCurrentJam(); err != nil {
-			page.session.setFlashMessage("Error archiving jam", "error")
+			page.session.setFlashMessage("Error archiving jam: "+err.Error(), "error")
 			fmt.Println(err.Error())
 		}
 		redirect("/admin/jam", w, req)
+	} else if id == "import" {
+		// Add a jam from a bundle to the archive
+		if req.Method != "POST" {
+			redirect("/admin/archive", w, req)
+			return
+		}
+		a, err := importBundleRequest(w, req)
+		if err != nil {
+			page.session.setFlashMessage("Error importing jam: "+err.Error(), "error")
+			fmt.Println(err.Error())
+			redirect("/admin/archive", w, req)
+			return
+		}
+		page.session.setFlashMessage("Imported "+a.Name, "success")
+		redirect("/admin/archive/"+a.UUID, w, req)
+	} else if id != "" && vars["function"] == "export" {
+		// Download the jam as a bundle, "current" is the current jam
+		a, err := m.getBundleJam(id)
+		if err != nil {
+			page.session.setFlashMessage("Error exporting jam: "+err.Error(), "error")
+			redirect("/admin/archive", w, req)
+			return
+		}
+		w.Header().Set("Content-Type", "application/zip")
+		w.Header().Set("Content-Disposition", "attachment; filename="+strconv.Quote(a.bundleFilename()))
+		if err = a.WriteBundle(w); err != nil {
+			// It's too late to tell them, the download is already underway
+			fmt.Println("Error exporting jam: " + err.Error())
+		}
+	} else if id != "" && vars["function"] == "restore" {
+		// Restoring replaces the current jam, so it has to be posted from the
+		// confirmation on the archived jam's page
+		if req.Method != "POST" {
+			redirect("/admin/archive/"+id, w, req)
+			return
+		}
+		if err := m.RestoreArchivedJam(id); err != nil {
+			page.session.setFlashMessage("Error restoring jam: "+err.Error(), "error")
+			fmt.Println(err.Error())
+			redirect("/admin/archive/"+id, w, req)
+			return
+		}
+		page.session.setFlashMessage("Restored "+m.jam.Name+" as the current jam", "success")
+		redirect("/admin", w, req)
 	} else if id != "" {
 		// Display a specific archive
 		agj := new(ArchivedGamejam)
@@ -26,20 +72,24 @@ func handleAdminArchive(w http.ResponseWriter, req *http.Request, page *pageData
 				agj.UUID = v.UUID
 				agj.Name = v.Name
 				agj.Date = v.Date
+				agj.TallyMethod = v.TallyMethod
 				agj.Rankings = v.Rankings
+				agj.RankPlaces = v.RankPlaces
+				agj.RankTieBreaks = v.RankTieBreaks
+				agj.Categories = append([]ArchivedCategory{}, v.Categories...)
+				agj.JudgeCriteria = v.JudgeCriteria
+				agj.JudgeWeight = v.JudgeWeight
+				agj.JudgeScores = v.JudgeScores
+				agj.CombinedStandings = v.CombinedStandings
 				agj.Teams = v.Teams
 				agj.Votes = v.Votes
 				break
 			}
 		}
 		// We want to replace the team UUIDs in the rankings with their name
-		for k, v := range agj.Rankings {
-			for _, tv := range agj.Teams {
-				if tv.UUID == v {
-					agj.Rankings[k] = tv.Name
-					break
-				}
-			}
+		agj.Rankings = agj.teamNames(agj.Rankings)
+		for k := range agj.Categories {
+			agj.Categories[k].Rankings = agj.teamNames(agj.Categories[k].Rankings)
 		}
 		page.TemplateData = agj
 		page.SubTitle = "Archived Game Jam"
@@ -51,3 +101,20 @@ func handleAdminArchive(w http.ResponseWriter, req *http.Request, page *pageData
 		page.show("admin-archive.html", w)
 	}
 }
+
+// The biggest bundle that can be uploaded
+const maxBundleUpload = 1 << 30
+
+// importBundleRequest imports the bundle uploaded with the request
+func importBundleRequest(w http.ResponseWriter, req *http.Request) (*ArchivedGamejam, error) {
+	req.Body = http.MaxBytesReader(w, req.Body, maxBundleUpload)
+	file, hdr, err := req.FormFile("bundlefile")
+	if err != nil {
+		if err == http.ErrMissingFile {
+			return nil, errors.New("No file was uploaded")
+		}
+		return nil, errors.New("The upload failed, bundles can't be bigger than " + formatBytes(maxBundleUpload))
+	}
+	defer file.Close()
+	return m.ImportBundle(file, hdr.Size)
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+)
+
+func handleAdminBackups(w http.ResponseWriter, req *http.Request, page *pageData) {
+	vars := mux.Vars(req)
+	page.SubTitle = "Backups"
+	if vars["id"] == "create" {
+		// Take a backup right now
+		if req.Method != "POST" {
+			redirect("/admin/backups", w, req)
+			return
+		}
+		if bk, err := m.backup("manual"); err != nil {
+			page.session.setFlashMessage("Error backing up: "+err.Error(), "error")
+			fmt.Println(err.Error())
+		} else {
+			page.session.setFlashMessage("Backed up to "+bk.Name, "success")
+		}
+		redirect("/admin/backups", w, req)
+		return
+	}
+	type backupsPageData struct {
+		Backups  []Backup
+		Dir      string
+		Interval int
+		Keep     int
+	}
+	bpd := backupsPageData{Dir: BackupDir, Interval: m.site.BackupInterval, Keep: m.site.BackupKeep}
+	var err error
+	if bpd.Backups, err = ListBackups(); err != nil {
+		page.FlashMessage = "Error reading backups: " + err.Error()
+		page.FlashClass = "error"
+	}
+	page.TemplateData = bpd
+	page.show("admin-backups.html", w)
+}
@@ -3,6 +3,7 @@ package main
 import (
 	"net"
 	"net/http"
+	"strings"
 
 	"github.com/gorilla/mux"
 )
@@ -15,8 +16,10 @@ func handleAdminClients(w http.ResponseWriter, req *http.Request, page *pageData
 	if err != nil {
 		client = NewClient(clientId)
 	}
-	clientIp, _, _ := net.SplitHostPort(req.RemoteAddr)
-	if clientId == "" {
+	clientIp := requestIp(req)
+	if clientId == "pair" {
+		handleAdminPairClient(w, req, page)
+	} else if clientId == "" {
 		type clientsPageData struct {
 			Clients []Client
 		}
@@ -46,37 +49,98 @@ func handleAdminClients(w http.ResponseWriter, req *http.Request, page *pageData
 			}
 			redirect("/admin/clients", w, req)
 		case "auth":
-			email := req.FormValue("email")
-			password := req.FormValue("password")
-			clientName := req.FormValue("clientname")
-			// Authentication isn't required to set a client name
-			if clientName != "" {
+			if clientName := req.FormValue("clientname"); clientName != "" {
 				client.Name = clientName
 			}
 			client.IP = clientIp
-			m.UpdateClient(client)
-			if page.LoggedIn || doLogin(email, password) == nil {
-				// Received a valid login
-				// Authenticate the client
-				client.Auth = true
-				m.UpdateClient(client)
+			client.Auth = true
+			if err := m.UpdateClient(client); err != nil {
+				page.session.setFlashMessage(err.Error(), "error")
+			} else {
 				page.session.setFlashMessage("Client Authenticated", "success")
-				if page.LoggedIn {
-					redirect("/admin/clients", w, req)
-				}
 			}
-			redirect("/", w, req)
+			redirect("/admin/clients", w, req)
 		case "deauth":
 			client.Auth = false
-			m.UpdateClient(client)
-			page.session.setFlashMessage("Client De-Authenticated", "success")
+			if err := m.UpdateClient(client); err != nil {
+				page.session.setFlashMessage(err.Error(), "error")
+			} else {
+				page.session.setFlashMessage("Client De-Authenticated", "success")
+			}
 			redirect("/admin/clients", w, req)
 		}
 	}
 }
 
+// handleAdminPairClient authenticates the client showing a pairing code
+// The QR code on the client links to the form with the code filled in
+func handleAdminPairClient(w http.ResponseWriter, req *http.Request, page *pageData) {
+	code := req.FormValue("code")
+	pc, err := m.GetPairingCode(code)
+	if err != nil {
+		page.session.setFlashMessage(err.Error(), "error")
+		redirect("/admin/clients", w, req)
+		return
+	}
+	if req.Method != "POST" {
+		type pairClientPageData struct {
+			Code string
+			Ip   string
+			Name string
+		}
+		pd := pairClientPageData{Code: pc.Display(), Ip: pc.IP}
+		if cl, err := m.GetClient(pc.ClientId); err == nil {
+			pd.Name = cl.Name
+		}
+		page.SubTitle = "Pair Client"
+		page.TemplateData = pd
+		page.show("admin-pairclient.html", w)
+		return
+	}
+	if client, err := m.PairClient(code, req.FormValue("clientname")); err != nil {
+		page.session.setFlashMessage("Error pairing client: "+err.Error(), "error")
+	} else {
+		page.session.setFlashMessage("Client Authenticated: "+client.Name, "success")
+	}
+	redirect("/admin/clients", w, req)
+}
+
+// requestIp returns the IP address of the client that made req
+// A request from a trusted proxy is from the last address in X-Forwarded-For
+// that isn't also a trusted proxy. Anyone can send X-Forwarded-For, so it's
+// ignored from anywhere else.
+func requestIp(req *http.Request) string {
+	ip, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		ip = req.RemoteAddr
+	}
+	if !m.site.IsTrustedProxy(ip) {
+		return ip
+	}
+	var hops []string
+	for _, hdr := range req.Header["X-Forwarded-For"] {
+		for _, v := range strings.Split(hdr, ",") {
+			hops = append(hops, strings.TrimSpace(v))
+		}
+	}
+	// Each proxy adds the address it got the request from to the end
+	for i := len(hops) - 1; i >= 0; i-- {
+		if net.ParseIP(hops[i]) == nil {
+			// Nothing past this can be trusted
+			break
+		}
+		ip = hops[i]
+		if !m.site.IsTrustedProxy(ip) {
+			break
+		}
+	}
+	return ip
+}
+
+// clientIsServer returns whether the request is from the machine the site
+// is running on
 func clientIsServer(req *http.Request) bool {
-	clientIp, _, _ := net.SplitHostPort(req.RemoteAddr)
+	clientIp := requestIp(req)
 	ifaces, err := net.Interfaces()
 	if err == nil {
 		for _, i := range ifaces {
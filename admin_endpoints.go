@@ -3,6 +3,7 @@ package main
 import (
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/gorilla/mux"
 )
@@ -21,12 +22,18 @@ func handleAdmin(w http.ResponseWriter, req *http.Request) {
 	page := initAdminRequest(w, req)
 	vars := mux.Vars(req)
 	if !page.LoggedIn {
-		if vars["category"] == "clients" &&
-			vars["id"] != "" &&
-			(vars["function"] == "add" || vars["function"] == "auth") {
-			// When authenticating a client, we have an all-in-one login/auth page
-			handleAdminClients(w, req, page)
+		if vars["category"] == "clients" && vars["id"] != "" && vars["function"] == "add" {
+			// A client that wants to be authenticated gets a pairing code, an
+			// admin approves it from their own device
+			page.SubTitle = "Authenticate Client"
+			showUnauthorized(page, w, req)
 		} else {
+			type loginPageData struct {
+				Return string // Where to go once they're logged in
+			}
+			if req.Method == "GET" {
+				page.TemplateData = loginPageData{Return: req.URL.RequestURI()}
+			}
 			page.SubTitle = "Admin Login"
 			page.show("admin-login.html", w)
 		}
@@ -35,6 +42,8 @@ func handleAdmin(w http.ResponseWriter, req *http.Request) {
 		switch adminCategory {
 		case "users":
 			handleAdminUsers(w, req, page)
+		case "judges":
+			handleAdminJudges(w, req, page)
 		case "teams":
 			handleAdminTeams(w, req, page)
 		case "games":
@@ -49,10 +58,30 @@ func handleAdmin(w http.ResponseWriter, req *http.Request) {
 			handleAdminSetAuthMode(w, req, page)
 		case "archive":
 			handleAdminArchive(w, req, page)
+		case "backups":
+			handleAdminBackups(w, req, page)
 		case "jam":
 			handleAdminJam(w, req, page)
+		case "stage":
+			handleAdminStage(w, req, page)
 		default:
-			page.TemplateData = getCondorcetResult()
+			type mainPageData struct {
+				Votes        int
+				Teams        int
+				Results      []Ranking
+				HasWindow    bool
+				VotingOpens  time.Time
+				VotingCloses time.Time
+			}
+			page.Scripts = append(page.Scripts, "/assets/js/live.js")
+			page.TemplateData = mainPageData{
+				Votes:        len(m.jam.Votes),
+				Teams:        len(m.jam.Teams),
+				Results:      m.jam.GetResults(),
+				HasWindow:    m.jam.HasVotingWindow(),
+				VotingOpens:  m.jam.VotingOpens,
+				VotingCloses: m.jam.VotingCloses,
+			}
 			page.show("admin-main.html", w)
 		}
 	}
@@ -66,6 +95,8 @@ func handleAdminSetMode(w http.ResponseWriter, req *http.Request, page *pageData
 	}
 	if err = m.site.SetPublicMode(newMode); err != nil {
 		page.session.setFlashMessage(err.Error(), "error")
+	} else if err = m.site.SaveToDB(); err != nil {
+		page.session.setFlashMessage("Error saving public mode: "+err.Error(), "error")
 	}
 	redirect("/admin", w, req)
 }
@@ -78,6 +109,8 @@ func handleAdminSetAuthMode(w http.ResponseWriter, req *http.Request, page *page
 	}
 	if err = m.site.SetAuthMode(newMode); err != nil {
 		page.session.setFlashMessage(err.Error(), "error")
+	} else if err = m.site.SaveToDB(); err != nil {
+		page.session.setFlashMessage("Error saving authentication mode: "+err.Error(), "error")
 	}
 	redirect("/admin", w, req)
 }
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// How often a comment is sent to idle event streams so that proxies
+// don't close them
+const eventKeepAlive = 30 * time.Second
+
+// handleAdminEvents streams live events to the admin pages as Server-Sent Events
+// It's routed on its own rather than through handleAdmin so that opening
+// the stream doesn't touch the session (and eat the flash message), and
+// so that it doesn't hold the model's lock for as long as it's open
+func handleAdminEvents(w http.ResponseWriter, req *http.Request) {
+	m.mu.Lock()
+	allowed := apiRequestIsAdmin(req)
+	if !allowed {
+		if tkn, err := m.checkToken(getBearerToken(req)); err == nil {
+			allowed = tkn.HasScope(ScopeResults)
+		}
+	}
+	m.mu.Unlock()
+	if !allowed {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "Streaming isn't supported", http.StatusInternalServerError)
+		return
+	}
+
+	ch := m.events.Subscribe()
+	defer m.events.Unsubscribe(ch)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+
+	// Start them off with where things stand right now
+	m.mu.Lock()
+	status := m.liveStatus(EventStatus)
+	m.mu.Unlock()
+	if msg, err := json.Marshal(status); err == nil {
+		fmt.Fprintf(w, "data: %s\n\n", msg)
+	}
+	flusher.Flush()
+
+	ticker := time.NewTicker(eventKeepAlive)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-req.Context().Done():
+			return
+		case msg, ok := <-ch:
+			if !ok {
+				return
+			}
+			fmt.Fprintf(w, "data: %s\n\n", msg)
+			flusher.Flush()
+		case <-ticker.C:
+			fmt.Fprint(w, ": keep-alive\n\n")
+			flusher.Flush()
+		}
+	}
+}
+
+// handleAdminStage shows the stage display, a full screen view of the
+// vote count and standings meant to be projected during voting
+func handleAdminStage(w http.ResponseWriter, req *http.Request, page *pageData) {
+	page.SubTitle = ""
+	page.HideAdminMenu = true
+	// The stage is full screen, so leave out admin.js, which makes room for the menu
+	page.Scripts = []string{"/assets/js/gjvote.js", "/assets/js/live.js"}
+	type stagePageData struct {
+		JamName     string
+		Votes       int
+		TallyMethod string
+		Results     []Ranking
+	}
+	spd := new(stagePageData)
+	spd.JamName = m.jam.Name
+	spd.Votes = len(m.jam.Votes)
+	spd.TallyMethod = m.jam.GetTallyMethodName()
+	spd.Results = m.jam.GetResults()
+	page.TemplateData = spd
+	page.show("admin-stage.html", w)
+}
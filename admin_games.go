@@ -1,17 +1,12 @@
 package main
 
 import (
-	"bytes"
-	"encoding/base64"
 	"errors"
-	"image"
-	"image/gif"
-	"image/jpeg"
+	"io"
+	"io/ioutil"
 	"net/http"
-	"strings"
 
 	"github.com/gorilla/mux"
-	"github.com/nfnt/resize"
 )
 
 func handleAdminGames(w http.ResponseWriter, req *http.Request, page *pageData) {
@@ -37,6 +32,8 @@ func handleAdminGames(w http.ResponseWriter, req *http.Request, page *pageData)
 				gm.Link = req.FormValue("gamelink")
 				gm.Framework = req.FormValue("gameframework")
 				gm.Description = req.FormValue("gamedesc")
+				// Editing the details doesn't touch the screenshots
+				gm.Screenshots = tm.Game.Screenshots
 				if err := m.jam.UpdateGame(tm.UUID, gm); err != nil {
 					page.session.setFlashMessage("Error updating game: "+err.Error(), "error")
 				} else {
@@ -50,11 +47,13 @@ func handleAdminGames(w http.ResponseWriter, req *http.Request, page *pageData)
 				if err != nil {
 					page.session.setFlashMessage("Error updating game: "+err.Error(), "error")
 					redirect("/admin/teams/"+tm.UUID+"#game", w, req)
+					break
 				}
-				ss, err = ssFromRequest(tm, req)
+				ss, err = ssFromRequest(w, tm, req)
 				if err != nil {
-					page.session.setFlashMessage("Error updating game: "+err.Error(), "error")
+					page.session.setFlashMessage("Error uploading screenshot: "+err.Error(), "error")
 					redirect("/admin/teams/"+tm.UUID+"#game", w, req)
+					break
 				}
 				gm := tm.Game
 				gm.Screenshots = append(gm.Screenshots, *ss)
@@ -95,47 +94,21 @@ func handleAdminGames(w http.ResponseWriter, req *http.Request, page *pageData)
 	}
 }
 
-func ssFromRequest(tm *Team, req *http.Request) (*Screenshot, error) {
-	var err error
-	var ss *Screenshot
-
-	file, hdr, err := req.FormFile("newssfile")
+// ssFromRequest reads an uploaded screenshot from the request
+func ssFromRequest(w http.ResponseWriter, tm *Team, req *http.Request) (*Screenshot, error) {
+	// Leave some room for the rest of the form
+	req.Body = http.MaxBytesReader(w, req.Body, int64(m.site.MaxUploadSize)+(1<<20))
+	file, _, err := req.FormFile("newssfile")
 	if err != nil {
-		return nil, err
-	}
-	extIdx := strings.LastIndex(hdr.Filename, ".")
-	fltp := "png"
-	if len(hdr.Filename) > extIdx {
-		fltp = hdr.Filename[extIdx+1:]
-	}
-	mI, _, err := image.Decode(file)
-	buf := new(bytes.Buffer)
-	// We convert everything to jpg
-	if err = jpeg.Encode(buf, mI, nil); err != nil {
-		return nil, errors.New("Unable to encode image")
-	}
-	thm := resize.Resize(200, 0, mI, resize.Lanczos3)
-	thmBuf := new(bytes.Buffer)
-	var thmString string
-	if fltp == "gif" {
-		if err = gif.Encode(thmBuf, thm, nil); err != nil {
-			return nil, errors.New("Unable to encode image")
-		}
-	} else {
-		if err = jpeg.Encode(thmBuf, thm, nil); err != nil {
-			return nil, errors.New("Unable to encode image")
+		if err == http.ErrMissingFile {
+			return nil, errors.New("No file was uploaded")
 		}
+		return nil, errors.New("The upload failed, screenshots can't be bigger than " + formatBytes(m.site.MaxUploadSize))
 	}
-	thmString = base64.StdEncoding.EncodeToString(thmBuf.Bytes())
-
-	if ss, err = NewScreenshot(tm.UUID, ""); err != nil {
-		return nil, err
+	defer file.Close()
+	dat, err := ioutil.ReadAll(io.LimitReader(file, int64(m.site.MaxUploadSize)+1))
+	if err != nil {
+		return nil, errors.New("Unable to read upload: " + err.Error())
 	}
-
-	ss.Image = base64.StdEncoding.EncodeToString(buf.Bytes())
-	ss.Thumbnail = thmString
-	ss.Filetype = fltp
-
-	return ss, nil
-	//return m.jam.SaveScreenshot(ss)
+	return m.processScreenshot(tm.UUID, dat)
 }
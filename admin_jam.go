@@ -1,7 +1,11 @@
 package main
 
 import (
+	"errors"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gorilla/mux"
 )
@@ -14,16 +18,112 @@ func handleAdminJam(w http.ResponseWriter, req *http.Request, page *pageData) {
 		gjName := req.FormValue("jam_name")
 		if gjName != "" {
 			m.jam.Name = gjName
-			err := m.saveChanges()
+		}
+		if tally := req.FormValue("tally_method"); isValidTallyMethod(tally) {
+			m.jam.TallyMethod = tally
+		}
+		if req.FormValue("judging_set") != "" {
+			m.jam.setJudgeCriteria(req.FormValue("judge_criteria"))
+			if weight, err := strconv.Atoi(req.FormValue("judge_weight")); err == nil && weight >= 0 && weight <= 100 {
+				m.jam.JudgeWeight = weight
+			}
+		}
+		if req.FormValue("voting_window_set") != "" {
+			opens, err := parseWindowInput(req.FormValue("voting_opens"))
 			if err == nil {
-				page.session.setFlashMessage("Game Jam Updated", "success")
-			} else {
-				page.session.setFlashMessage("Error saving Game Jam", "error")
+				var closes time.Time
+				if closes, err = parseWindowInput(req.FormValue("voting_closes")); err == nil {
+					err = m.jam.SetVotingWindow(opens, closes)
+				}
+			}
+			if err != nil {
+				page.session.setFlashMessage("Error setting voting window: "+err.Error(), "error")
+				redirect("/admin/jam", w, req)
+				return
 			}
 		}
+		if req.FormValue("tie_breaks_set") != "" {
+			req.ParseForm()
+			m.jam.TieBreaks = cleanTieBreaks(req.Form["tie_break"])
+		}
+		if err := m.jam.SaveSettings(); err == nil {
+			page.session.setFlashMessage("Game Jam Updated", "success")
+		} else {
+			page.session.setFlashMessage("Error saving Game Jam", "error")
+		}
+		redirect("/admin/jam", w, req)
+	} else if fn == "addcategory" {
+		cat := NewCategory("")
+		cat.Name = strings.TrimSpace(req.FormValue("category_name"))
+		if err := m.jam.AddCategory(cat); err != nil {
+			page.session.setFlashMessage("Error adding category: "+err.Error(), "error")
+		} else if err := m.jam.SaveCategories(); err != nil {
+			page.session.setFlashMessage("Error saving category: "+err.Error(), "error")
+		} else {
+			page.session.setFlashMessage("Category "+cat.Name+" added", "success")
+		}
+		redirect("/admin/jam", w, req)
+	} else if fn == "deletecategory" {
+		if err := m.jam.RemoveCategoryById(vars["function"]); err != nil {
+			page.session.setFlashMessage("Error removing category: "+err.Error(), "error")
+		} else if err := m.jam.SaveCategories(); err != nil {
+			page.session.setFlashMessage("Error saving categories: "+err.Error(), "error")
+		} else {
+			page.session.setFlashMessage("Category removed", "success")
+		}
 		redirect("/admin/jam", w, req)
 	} else {
-		page.TemplateData = m.jam
+		type tallyOption struct {
+			Key  string
+			Name string
+		}
+		type tieBreakSlot struct {
+			Selected string
+		}
+		type jamPageData struct {
+			Jam          *Gamejam
+			TallyMethods []tallyOption
+			TieBreakers  []tallyOption
+			TieBreaks    []tieBreakSlot
+			VotingOpens  string
+			VotingCloses string
+		}
+		jpd := new(jamPageData)
+		jpd.Jam = m.jam
+		if !m.jam.VotingOpens.IsZero() {
+			jpd.VotingOpens = m.jam.VotingOpens.Format(votingWindowInputFormat)
+		}
+		if !m.jam.VotingCloses.IsZero() {
+			jpd.VotingCloses = m.jam.VotingCloses.Format(votingWindowInputFormat)
+		}
+		for _, k := range tallyMethodKeys {
+			jpd.TallyMethods = append(jpd.TallyMethods, tallyOption{Key: k, Name: getTallyMethod(k).Name()})
+		}
+		for _, k := range tieBreakerKeys {
+			jpd.TieBreakers = append(jpd.TieBreakers, tallyOption{Key: k, Name: getTieBreakerName(k)})
+		}
+		// One slot for each available tie-breaker
+		for i := range tieBreakerKeys {
+			slot := tieBreakSlot{}
+			if i < len(m.jam.TieBreaks) {
+				slot.Selected = m.jam.TieBreaks[i]
+			}
+			jpd.TieBreaks = append(jpd.TieBreaks, slot)
+		}
+		page.TemplateData = jpd
 		page.show("admin-jam.html", w)
 	}
 }
+
+// parseWindowInput parses a voting window time from the jam form
+// A blank value is the zero time, leaving that end of the window unscheduled
+func parseWindowInput(val string) (time.Time, error) {
+	if strings.TrimSpace(val) == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.ParseInLocation(votingWindowInputFormat, strings.TrimSpace(val), time.Local)
+	if err != nil {
+		return time.Time{}, errors.New("Invalid time: " + val)
+	}
+	return t, nil
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+)
+
+// handleAdminJudges
+func handleAdminJudges(w http.ResponseWriter, req *http.Request, page *pageData) {
+	vars := mux.Vars(req)
+	page.SubTitle = "Judges"
+	email := vars["id"]
+	if email == "new" {
+		email = strings.TrimSpace(req.FormValue("email"))
+		password := req.FormValue("password")
+		if email == "" || password == "" {
+			page.session.setFlashMessage("An email address and password are required", "error")
+		} else if password != req.FormValue("password_rpt") {
+			page.session.setFlashMessage("Entered passwords don't match", "error")
+		} else if m.isValidJudgeEmail(email) {
+			page.session.setFlashMessage("A judge with email address "+email+" already exists!", "error")
+		} else if err := m.updateJudgePassword(email, password); err != nil {
+			page.session.setFlashMessage(err.Error(), "error")
+		} else {
+			page.session.setFlashMessage("Judge "+email+" created!", "success")
+		}
+		redirect("/admin/judges", w, req)
+	} else if email != "" {
+		if vars["function"] == "delete" && m.isValidJudgeEmail(email) {
+			if err := m.deleteJudge(email); err != nil {
+				page.session.setFlashMessage(err.Error(), "error")
+			} else {
+				page.session.setFlashMessage("Judge "+email+" deleted!", "success")
+			}
+		}
+		redirect("/admin/judges", w, req)
+	} else {
+		type judgeRow struct {
+			Email  string
+			Scored int
+		}
+		type judgesPageData struct {
+			Judges   []judgeRow
+			Criteria []string
+			Games    int
+		}
+		jpd := new(judgesPageData)
+		jpd.Criteria = m.jam.JudgeCriteria
+		jpd.Games = len(m.jam.Teams)
+		for _, v := range m.getAllJudges() {
+			row := judgeRow{Email: v}
+			for _, js := range m.jam.JudgeScores {
+				if js.Judge == v {
+					row.Scored++
+				}
+			}
+			jpd.Judges = append(jpd.Judges, row)
+		}
+		page.TemplateData = jpd
+		page.show("admin-judges.html", w)
+	}
+}
@@ -37,7 +37,11 @@ func handleAdminTeams(w http.ResponseWriter, req *http.Request, page *pageData)
 			switch vars["function"] {
 			case "save":
 				tm.Name = req.FormValue("teamname")
-				page.session.setFlashMessage("Team Updated!", "success")
+				if err := m.jam.UpdateTeam(tm); err != nil {
+					page.session.setFlashMessage("Error updating team: "+err.Error(), "error")
+				} else {
+					page.session.setFlashMessage("Team Updated!", "success")
+				}
 				redirect("/admin/teams", w, req)
 			case "delete":
 				var err error
@@ -55,9 +59,12 @@ func handleAdminTeams(w http.ResponseWriter, req *http.Request, page *pageData)
 					mbr.SlackId = req.FormValue("newmemberslackid")
 					mbr.Twitter = req.FormValue("newmembertwitter")
 					mbr.Email = req.FormValue("newmemberemail")
+					mbr.Public = req.FormValue("newmemberpublic") == "true"
 				}
 				if err := tm.AddTeamMember(mbr); err != nil {
 					page.session.setFlashMessage("Error adding team member: "+err.Error(), "error")
+				} else if err = m.jam.UpdateTeam(tm); err != nil {
+					page.session.setFlashMessage("Error adding team member: "+err.Error(), "error")
 				} else {
 					page.session.setFlashMessage(mbrName+" added to team!", "success")
 				}
@@ -73,10 +80,24 @@ func handleAdminTeams(w http.ResponseWriter, req *http.Request, page *pageData)
 				if err = tm.RemoveTeamMemberById(mbr.UUID); err != nil {
 					fmt.Println("Error removing team member: " + err.Error())
 					page.session.setFlashMessage("Error deleting team member", "error")
+				} else if err = m.jam.UpdateTeam(tm); err != nil {
+					fmt.Println("Error removing team member: " + err.Error())
+					page.session.setFlashMessage("Error deleting team member", "error")
 				} else {
 					page.session.setFlashMessage(mbr.Name+" deleted from team", "success")
 				}
 				redirect("/admin/teams/"+teamId+"#members", w, req)
+			case "memberpublic":
+				// Toggle whether the member is listed on the public archive
+				mbr, err := tm.GetTeamMemberById(req.FormValue("memberid"))
+				if err == nil {
+					mbr.Public = !mbr.Public
+					err = m.jam.UpdateTeam(tm)
+				}
+				if err != nil {
+					page.session.setFlashMessage("Error updating team member: "+err.Error(), "error")
+				}
+				redirect("/admin/teams/"+teamId+"#members", w, req)
 			default:
 				page.SubTitle = "Edit Team"
 				t, err := m.jam.GetTeamById(teamId)
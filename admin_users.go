@@ -22,6 +22,11 @@ func handleAdminDoLogin(w http.ResponseWriter, req *http.Request) {
 	} else {
 		page.session.setStringValue("email", email)
 	}
+	// Go back to the page that asked them to log in, if it was an admin page
+	if ret := req.FormValue("return"); strings.HasPrefix(ret, "/admin/") {
+		redirect(ret, w, req)
+		return
+	}
 	redirect("/admin", w, req)
 }
 
@@ -49,7 +54,9 @@ func handleAdminUsers(w http.ResponseWriter, req *http.Request, page *pageData)
 	vars := mux.Vars(req)
 	page.SubTitle = "Admin Users"
 	email := vars["id"]
-	if email == "new" {
+	if email == "tokens" {
+		handleAdminTokens(w, req, page)
+	} else if email == "new" {
 		switch vars["function"] {
 		case "save":
 			email = req.FormValue("email")
@@ -104,12 +111,51 @@ func handleAdminUsers(w http.ResponseWriter, req *http.Request, page *pageData)
 			page.show("admin-edituser.html", w)
 		}
 	} else {
-		type usersPageData struct {
-			Users []string
-		}
-		page.TemplateData = usersPageData{Users: m.getAllUsers()}
+		showAdminUsers(w, page, "")
+	}
+}
 
-		page.SubTitle = "Admin Users"
-		page.show("admin-users.html", w)
+// showAdminUsers shows the list of users and API tokens
+// newToken is only set right after a token is created, it can't be shown again
+func showAdminUsers(w http.ResponseWriter, page *pageData, newToken string) {
+	type usersPageData struct {
+		Users    []string
+		Tokens   []APIToken
+		Scopes   []string
+		NewToken string
+	}
+	page.TemplateData = usersPageData{
+		Users:    m.getAllUsers(),
+		Tokens:   m.getAllTokens(),
+		Scopes:   tokenScopes,
+		NewToken: newToken,
+	}
+
+	page.SubTitle = "Admin Users"
+	page.show("admin-users.html", w)
+}
+
+// handleAdminTokens creates and revokes API tokens
+func handleAdminTokens(w http.ResponseWriter, req *http.Request, page *pageData) {
+	vars := mux.Vars(req)
+	if vars["function"] == "create" {
+		req.ParseForm()
+		secret, tkn, err := m.createToken(req.FormValue("token_name"), req.Form["token_scope"])
+		if err != nil {
+			page.session.setFlashMessage("Error creating token: "+err.Error(), "error")
+			redirect("/admin/users", w, req)
+			return
+		}
+		page.FlashMessage, page.FlashClass = "Token "+tkn.Name+" created, copy it now, it won't be shown again", "success"
+		showAdminUsers(w, page, secret)
+		return
+	}
+	if vars["subid"] == "revoke" {
+		if err := m.deleteToken(vars["function"]); err != nil {
+			page.session.setFlashMessage("Error revoking token: "+err.Error(), "error")
+		} else {
+			page.session.setFlashMessage("Token revoked", "success")
+		}
 	}
+	redirect("/admin/users", w, req)
 }
@@ -2,135 +2,75 @@ package main
 
 import (
 	"errors"
+	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/gorilla/mux"
 )
 
-type Ranking struct {
-	Rank  int
-	Teams []Team
-}
-
-// getCondorcetResult returns the ranking of teams based on the condorcet method
-// https://en.wikipedia.org/wiki/Condorcet_method
-func getCondorcetResult() []Ranking {
-	type teamPair struct {
-		winner   *Team
-		loser    *Team
-		majority float32
-	}
-	var allPairs []teamPair
-	var ret []Ranking
-	for i := 0; i < len(m.jam.Teams); i++ {
-		for j := i + 1; j < len(m.jam.Teams); j++ {
-			// For each pairing find a winner
-			winner, pct, _ := findWinnerBetweenTeams(&m.jam.Teams[i], &m.jam.Teams[j])
-			newPair := new(teamPair)
-			if winner != nil {
-				newPair.winner = winner
-				if winner.UUID == m.jam.Teams[i].UUID {
-					newPair.loser = &m.jam.Teams[j]
-				} else {
-					newPair.loser = &m.jam.Teams[i]
-				}
-				newPair.majority = pct
-			} else {
-				newPair.winner = &m.jam.Teams[i]
-				newPair.loser = &m.jam.Teams[j]
-				newPair.majority = 50
+func handleAdminVotes(w http.ResponseWriter, req *http.Request, page *pageData) {
+	vars := mux.Vars(req)
+	page.SubTitle = "Votes"
+	if vars["id"] == "coinflip" {
+		// Record the result of a coin flip for tied teams, the winner first
+		req.ParseForm()
+		var order []string
+		seen := make(map[string]bool)
+		for _, v := range req.Form["coinflip"] {
+			if v != "" && !seen[v] {
+				seen[v] = true
+				order = append(order, v)
 			}
-			allPairs = append(allPairs, *newPair)
 		}
-	}
-	// initialize map of team wins
-	teamWins := make(map[string]int)
-	for i := range m.jam.Teams {
-		teamWins[m.jam.Teams[i].UUID] = 0
-	}
-	// Figure out how many wins each team has
-	for i := range allPairs {
-		if allPairs[i].majority != 50 {
-			teamWins[allPairs[i].winner.UUID]++
-		}
-	}
-
-	// Rank them by wins
-	rankedWins := make(map[int][]string)
-	for k, v := range teamWins {
-		rankedWins[v] = append(rankedWins[v], k)
-	}
-	currRank := 1
-	for len(rankedWins) > 0 {
-		topWins := 0
-		for k, _ := range rankedWins {
-			if k > topWins {
-				topWins = k
+		var err error
+		if len(order) < 2 || len(order) != len(req.Form["coinflip"]) {
+			err = errors.New("Each tied team must be placed exactly once")
+		} else if catId := req.FormValue("category"); catId != "" {
+			// A tie in an award category
+			var cat *Category
+			if cat, err = m.jam.GetCategoryById(catId); err == nil {
+				cat.RecordCoinFlip(order)
+				m.jam.IsChanged = true
+				err = m.jam.SaveCategories()
 			}
+		} else if err = m.jam.RecordCoinFlip(order); err == nil {
+			err = m.jam.SaveSettings()
 		}
-		nR := new(Ranking)
-		nR.Rank = currRank
-		for i := range rankedWins[topWins] {
-			tm, _ := m.jam.GetTeamById(rankedWins[topWins][i])
-			if tm != nil {
-				nR.Teams = append(nR.Teams, *tm)
-			}
+		if err != nil {
+			page.session.setFlashMessage("Error recording coin flip: "+err.Error(), "error")
+		} else {
+			page.session.setFlashMessage("Coin flip recorded", "success")
 		}
-		ret = append(ret, *nR)
-		delete(rankedWins, topWins)
-		currRank++
+		redirect("/admin/votes", w, req)
+		return
 	}
-	return ret
-}
 
-// This is a helper function for calculating results
-func uuidIsInRankingSlice(uuid string, sl []Ranking) bool {
-	for _, v := range sl {
-		for i := range v.Teams {
-			if v.Teams[i].UUID == uuid {
-				return true
-			}
+	if vars["id"] == "export" {
+		// Download the ballots, of the current jam or the archived jam in the url
+		format := req.FormValue("format")
+		bs, err := m.getBallotSet(vars["function"], req.FormValue("category"))
+		if err == nil && !validBallotFormat(format) {
+			err = errors.New("Invalid export format: " + format)
 		}
-	}
-	return false
-}
-
-// findWinnerBetweenTeams returns the team that got the most votes
-// and the percentage of votes they received
-// or an error if a winner couldn't be determined.
-func findWinnerBetweenTeams(tm1, tm2 *Team) (*Team, float32, error) {
-	// tally gets incremented for a tm1 win, decremented for a tm2 win
-	var tm1votes, tm2votes float32
-	for _, v := range m.jam.Votes {
-		for _, chc := range v.Choices {
-			if chc.Team == tm1.UUID {
-				tm1votes++
-				break
-			} else if chc.Team == tm2.UUID {
-				tm2votes++
-				break
+		if err != nil {
+			page.session.setFlashMessage("Error exporting votes: "+err.Error(), "error")
+			if vars["function"] != "" && vars["function"] != "current" {
+				redirect("/admin/archive/"+vars["function"], w, req)
+			} else {
+				redirect("/admin/votes", w, req)
 			}
+			return
 		}
+		w.Header().Set("Content-Type", ballotContentTypes[format])
+		w.Header().Set("Content-Disposition", "attachment; filename="+strconv.Quote(bs.Filename(format)))
+		if err = bs.Write(w, format); err != nil {
+			fmt.Println("Error exporting votes: " + err.Error())
+		}
+		return
 	}
-	ttlVotes := tm1votes + tm2votes
-	if tm1votes > tm2votes {
-		return tm1, 100 * (tm1votes / ttlVotes), nil
-	} else if tm1votes < tm2votes {
-		return tm2, 100 * (tm2votes / ttlVotes), nil
-	}
-	return nil, 50, errors.New("Unable to determine a winner")
-}
-
-func getInstantRunoffResult() []Team {
-	var ret []Team
-	return ret
-}
-
-func handleAdminVotes(w http.ResponseWriter, req *http.Request, page *pageData) {
-	vars := mux.Vars(req)
-	page.SubTitle = "Votes"
 
 	type vpdVote struct {
 		Timestamp   string
@@ -139,9 +79,24 @@ func handleAdminVotes(w http.ResponseWriter, req *http.Request, page *pageData)
 		VoterStatus string
 		Discovery   string
 	}
+	type vpdCategory struct {
+		Category Category
+		Ballots  int
+		Results  []Ranking
+		Ties     []Ranking
+	}
 	type votePageData struct {
 		AllVotes      []vpdVote
+		TallyMethod   string
+		TieBreaks     []string
 		Results       []Ranking
+		Ties          []Ranking
+		Categories    []vpdCategory
+		Combined      []CombinedStanding
+		JudgeWeight   int
+		RunoffResults []Ranking
+		RunoffRounds  []RunoffRound
+		Pairwise      *PairwiseMatrix
 		VoterStatuses map[string]int
 	}
 	vpd := new(votePageData)
@@ -171,7 +126,31 @@ func handleAdminVotes(w http.ResponseWriter, req *http.Request, page *pageData)
 		v.Discovery = m.jam.Votes[i].Discovery
 		vpd.AllVotes = append(vpd.AllVotes, *v)
 	}
-	vpd.Results = getCondorcetResult()
+	vpd.TallyMethod = m.jam.GetTallyMethodName()
+	vpd.Results = m.jam.GetResults()
+	vpd.TieBreaks = m.jam.GetTieBreakNames()
+	for _, v := range vpd.Results {
+		if len(v.Teams) > 1 {
+			vpd.Ties = append(vpd.Ties, v)
+		}
+	}
+	for i := range m.jam.Categories {
+		cat := vpdCategory{Category: m.jam.Categories[i]}
+		cat.Ballots = len(votesForCategory(m.jam.Votes, cat.Category.UUID))
+		cat.Results = m.jam.GetCategoryResults(&m.jam.Categories[i])
+		for _, v := range cat.Results {
+			if len(v.Teams) > 1 {
+				cat.Ties = append(cat.Ties, v)
+			}
+		}
+		vpd.Categories = append(vpd.Categories, cat)
+	}
+	if len(m.jam.JudgeCriteria) > 0 {
+		vpd.Combined = m.jam.GetCombinedStandings()
+		vpd.JudgeWeight = m.jam.JudgeWeight
+	}
+	vpd.RunoffResults, vpd.RunoffRounds = getInstantRunoffResult(m.jam.Teams, m.jam.Votes)
+	vpd.Pairwise = NewPairwiseMatrix(m.jam.Teams, m.jam.Votes)
 	page.TemplateData = vpd
 
 	switch vars["function"] {
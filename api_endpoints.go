@@ -0,0 +1,709 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+/**
+ * API
+ * Versioned JSON endpoints under /api/v1
+ * Requests are authenticated by an admin session or by an API token sent
+ * as an "Authorization: Bearer <token>" header. Results need the results
+ * scope, team changes need the teams scope, and votes and team member
+ * contact details are only returned to admins. Anyone else only sees the
+ * team members that asked to be listed.
+ * Changes made with an admin session have to be sent as application/json,
+ * a page on another site can't send that without the browser asking first
+ * (and the API never says yes), so it can't use an admin's session.
+ */
+
+type apiErrorBody struct {
+	Error apiError `json:"error"`
+}
+
+type apiError struct {
+	Status  int    `json:"status"`
+	Message string `json:"message"`
+}
+
+type apiJam struct {
+	UUID          string        `json:"uuid,omitempty"`
+	Name          string        `json:"name"`
+	Date          string        `json:"date,omitempty"`
+	TallyMethod   string        `json:"tally_method"`
+	TieBreaks     []string      `json:"tie_breaks"`
+	Categories    []apiCategory `json:"categories"`
+	JudgeCriteria []string      `json:"judge_criteria"`
+	JudgeWeight   int           `json:"judge_weight"`
+	VotingOpens   string        `json:"voting_opens,omitempty"`
+	VotingCloses  string        `json:"voting_closes,omitempty"`
+	Teams         int           `json:"team_count"`
+	Votes         int           `json:"vote_count"`
+}
+
+type apiCategory struct {
+	UUID string `json:"uuid"`
+	Name string `json:"name"`
+}
+
+type apiTeam struct {
+	UUID    string      `json:"uuid"`
+	Name    string      `json:"name"`
+	Members []apiMember `json:"members"`
+	Game    *apiGame    `json:"game"`
+}
+
+type apiMember struct {
+	UUID    string `json:"uuid"`
+	Name    string `json:"name"`
+	SlackId string `json:"slack_id,omitempty"`
+	Twitter string `json:"twitter,omitempty"`
+	Email   string `json:"email,omitempty"`
+	Public  bool   `json:"public"`
+}
+
+type apiGame struct {
+	TeamId      string          `json:"team_id"`
+	Name        string          `json:"name"`
+	Link        string          `json:"link"`
+	Description string          `json:"description"`
+	Framework   string          `json:"framework"`
+	Screenshots []apiScreenshot `json:"screenshots"`
+}
+
+type apiScreenshot struct {
+	UUID         string `json:"uuid"`
+	Description  string `json:"description"`
+	Filetype     string `json:"filetype"`
+	ImageURL     string `json:"image_url"`
+	ThumbnailURL string `json:"thumbnail_url"`
+}
+
+type apiVote struct {
+	ClientId        string              `json:"client_id"`
+	Timestamp       time.Time           `json:"timestamp"`
+	Choices         []string            `json:"choices"`
+	CategoryChoices map[string][]string `json:"category_choices,omitempty"`
+	VoterStatus     string              `json:"voter_status"`
+	Discovery       string              `json:"discovery"`
+}
+
+type apiRanking struct {
+	Rank     int          `json:"rank"`
+	Teams    []apiTeamRef `json:"teams"`
+	TieBreak string       `json:"tie_break,omitempty"`
+}
+
+type apiTeamRef struct {
+	UUID     string `json:"uuid"`
+	Name     string `json:"name"`
+	GameName string `json:"game_name"`
+}
+
+type apiCategoryResults struct {
+	apiCategory
+	Results []apiRanking `json:"results"`
+}
+
+type apiCombinedStanding struct {
+	Rank         int        `json:"rank"`
+	Team         apiTeamRef `json:"team"`
+	PublicRank   int        `json:"public_rank"`
+	JudgeAverage float64    `json:"judge_average"`
+	Combined     float64    `json:"combined"`
+}
+
+type apiResults struct {
+	TallyMethod string                `json:"tally_method"`
+	Results     []apiRanking          `json:"results"`
+	Categories  []apiCategoryResults  `json:"categories"`
+	Combined    []apiCombinedStanding `json:"combined,omitempty"`
+}
+
+type apiArchivedJam struct {
+	UUID        string               `json:"uuid"`
+	Name        string               `json:"name"`
+	Date        string               `json:"date,omitempty"`
+	TallyMethod string               `json:"tally_method"`
+	Results     []apiRanking         `json:"results"`
+	Categories  []apiCategoryResults `json:"categories"`
+	Teams       []apiTeam            `json:"teams,omitempty"`
+}
+
+// apiRequest is what the API handlers get to work with
+type apiRequest struct {
+	w       http.ResponseWriter
+	req     *http.Request
+	vars    map[string]string
+	isAdmin bool
+	token   *APIToken
+}
+
+// apiMethods routes an API request to the handler for its HTTP method
+type apiMethods map[string]func(*apiRequest)
+
+// apiGet is an API endpoint that only answers GET requests
+func apiGet(h func(*apiRequest)) apiMethods {
+	return apiMethods{http.MethodGet: h}
+}
+
+func (am apiMethods) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	method := req.Method
+	if method == http.MethodHead {
+		method = http.MethodGet
+	}
+	h, ok := am[method]
+	if !ok {
+		var allowed []string
+		for k := range am {
+			allowed = append(allowed, k)
+		}
+		sort.Strings(allowed)
+		w.Header().Set("Allow", strings.Join(allowed, ", "))
+		apiWriteError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	a := &apiRequest{
+		w:       w,
+		req:     req,
+		vars:    mux.Vars(req),
+		isAdmin: apiRequestIsAdmin(req),
+	}
+	if bearer := getBearerToken(req); bearer != "" {
+		tkn, err := m.checkToken(bearer)
+		if err != nil {
+			apiWriteError(w, http.StatusUnauthorized, "Invalid API token")
+			return
+		}
+		a.token = tkn
+		a.isAdmin = a.isAdmin || tkn.HasScope(ScopeAdmin)
+	} else if a.isAdmin && method != http.MethodGet && !isJSONRequest(req) {
+		apiWriteError(w, http.StatusUnsupportedMediaType, "Changes made with an admin session must be sent as application/json")
+		return
+	}
+	h(a)
+}
+
+// isJSONRequest is whether the request's body is sent as application/json
+func isJSONRequest(req *http.Request) bool {
+	mt, _, err := mime.ParseMediaType(req.Header.Get("Content-Type"))
+	return err == nil && mt == "application/json"
+}
+
+// getBearerToken returns the token from an "Authorization: Bearer" header
+func getBearerToken(req *http.Request) string {
+	auth := req.Header.Get("Authorization")
+	if len(auth) > 7 && strings.EqualFold(auth[:7], "Bearer ") {
+		return strings.TrimSpace(auth[7:])
+	}
+	return ""
+}
+
+// apiRequestIsAdmin checks the session cookie for a logged in admin
+// It doesn't create or save a session, API clients don't need one
+func apiRequestIsAdmin(req *http.Request) bool {
+	s, err := sessionStore.Get(req, m.site.SessionName)
+	if err != nil {
+		return false
+	}
+	email, ok := s.Values["email"].(string)
+	return ok && m.isValidUserEmail(email)
+}
+
+func apiWriteJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(status)
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		fmt.Println("Error encoding API response: " + err.Error())
+	}
+}
+
+func apiWriteError(w http.ResponseWriter, status int, msg string) {
+	apiWriteJSON(w, status, apiErrorBody{Error: apiError{Status: status, Message: msg}})
+}
+
+// requireScope writes a 401 or 403 and returns false if the request
+// isn't allowed to use scope
+func (a *apiRequest) requireScope(scope string) bool {
+	if a.isAdmin || (a.token != nil && a.token.HasScope(scope)) {
+		return true
+	}
+	if a.token == nil {
+		apiWriteError(a.w, http.StatusUnauthorized, "Authentication required")
+	} else {
+		apiWriteError(a.w, http.StatusForbidden, "This token doesn't have the "+scope+" scope")
+	}
+	return false
+}
+
+// readBody decodes the JSON request body into v, writing a 400 if it can't
+func (a *apiRequest) readBody(v interface{}) bool {
+	if err := json.NewDecoder(a.req.Body).Decode(v); err != nil {
+		apiWriteError(a.w, http.StatusBadRequest, "Invalid JSON body: "+err.Error())
+		return false
+	}
+	return true
+}
+
+// saved checks the error from saving a change, writing a 500 if there was one
+func (a *apiRequest) saved(err error) bool {
+	if err != nil {
+		apiWriteError(a.w, http.StatusInternalServerError, "Error saving changes: "+err.Error())
+		return false
+	}
+	return true
+}
+
+func (a *apiRequest) ok(v interface{}) {
+	apiWriteJSON(a.w, http.StatusOK, v)
+}
+
+func (a *apiRequest) notFound(msg string) {
+	apiWriteError(a.w, http.StatusNotFound, msg)
+}
+
+// handleApiNotFound answers any API path that doesn't exist
+func handleApiNotFound(w http.ResponseWriter, req *http.Request) {
+	apiWriteError(w, http.StatusNotFound, "Unknown API endpoint: "+req.URL.Path)
+}
+
+func handleApiJam(a *apiRequest) {
+	a.ok(newApiJam(m.jam))
+}
+
+func handleApiTeams(a *apiRequest) {
+	ret := []apiTeam{}
+	for i := range m.jam.Teams {
+		ret = append(ret, newApiTeam(&m.jam.Teams[i], a.isAdmin, ""))
+	}
+	a.ok(ret)
+}
+
+func handleApiTeam(a *apiRequest) {
+	tm, err := m.jam.GetTeamById(a.vars["id"])
+	if err != nil {
+		a.notFound(err.Error())
+		return
+	}
+	at := newApiTeam(tm, a.isAdmin, "")
+	switch a.vars["function"] {
+	case "":
+		a.ok(at)
+	case "members":
+		a.ok(at.Members)
+	case "game":
+		a.ok(at.Game)
+	case "screenshots":
+		a.ok(at.Game.Screenshots)
+	default:
+		a.notFound("Unknown team resource: " + a.vars["function"])
+	}
+}
+
+func handleApiAddTeam(a *apiRequest) {
+	if !a.requireScope(ScopeTeams) {
+		return
+	}
+	var body apiTeam
+	if !a.readBody(&body) {
+		return
+	}
+	tm := NewTeam("")
+	tm.Name = strings.TrimSpace(body.Name)
+	if tm.Name == "" {
+		apiWriteError(a.w, http.StatusBadRequest, "A team name is required")
+		return
+	}
+	if err := m.jam.AddTeam(tm); err != nil {
+		apiWriteError(a.w, http.StatusConflict, err.Error())
+		return
+	}
+	apiWriteJSON(a.w, http.StatusCreated, newApiTeam(tm, a.isAdmin, ""))
+}
+
+func handleApiUpdateTeam(a *apiRequest) {
+	if !a.requireScope(ScopeTeams) {
+		return
+	}
+	tm, err := m.jam.GetTeamById(a.vars["id"])
+	if err != nil {
+		a.notFound(err.Error())
+		return
+	}
+	var body apiTeam
+	if !a.readBody(&body) {
+		return
+	}
+	name := strings.TrimSpace(body.Name)
+	if name == "" {
+		apiWriteError(a.w, http.StatusBadRequest, "A team name is required")
+		return
+	}
+	if fnd, err := m.jam.GetTeamByName(name); err == nil && fnd.UUID != tm.UUID {
+		apiWriteError(a.w, http.StatusConflict, "A team with that Name already exists")
+		return
+	}
+	tm.Name = name
+	if a.saved(m.jam.UpdateTeam(tm)) {
+		a.ok(newApiTeam(tm, a.isAdmin, ""))
+	}
+}
+
+func handleApiDeleteTeam(a *apiRequest) {
+	if !a.requireScope(ScopeTeams) {
+		return
+	}
+	if _, err := m.jam.GetTeamById(a.vars["id"]); err != nil {
+		a.notFound(err.Error())
+		return
+	}
+	if a.saved(m.jam.RemoveTeamById(a.vars["id"])) {
+		a.w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// handleApiUpdateTeamResource handles PUT /teams/{id}/game
+func handleApiUpdateTeamResource(a *apiRequest) {
+	if !a.requireScope(ScopeTeams) {
+		return
+	}
+	tm, err := m.jam.GetTeamById(a.vars["id"])
+	if err != nil {
+		a.notFound(err.Error())
+		return
+	}
+	if a.vars["function"] != "game" {
+		a.notFound("Unknown team resource: " + a.vars["function"])
+		return
+	}
+	var body apiGame
+	if !a.readBody(&body) {
+		return
+	}
+	tm.Game.Name = body.Name
+	tm.Game.Link = body.Link
+	tm.Game.Description = body.Description
+	tm.Game.Framework = body.Framework
+	if a.saved(m.jam.UpdateGame(tm.UUID, tm.Game)) {
+		a.ok(newApiGame(tm.Game, ""))
+	}
+}
+
+// handleApiAddTeamResource handles POST /teams/{id}/members
+func handleApiAddTeamResource(a *apiRequest) {
+	if !a.requireScope(ScopeTeams) {
+		return
+	}
+	tm, err := m.jam.GetTeamById(a.vars["id"])
+	if err != nil {
+		a.notFound(err.Error())
+		return
+	}
+	if a.vars["function"] != "members" {
+		a.notFound("Unknown team resource: " + a.vars["function"])
+		return
+	}
+	var body apiMember
+	if !a.readBody(&body) {
+		return
+	}
+	if strings.TrimSpace(body.Name) == "" {
+		apiWriteError(a.w, http.StatusBadRequest, "A member name is required")
+		return
+	}
+	mbr, err := NewTeamMember(tm.UUID, "")
+	if err != nil {
+		apiWriteError(a.w, http.StatusBadRequest, err.Error())
+		return
+	}
+	mbr.Name = strings.TrimSpace(body.Name)
+	mbr.SlackId = body.SlackId
+	mbr.Twitter = body.Twitter
+	mbr.Email = body.Email
+	mbr.Public = body.Public
+	if err = tm.AddTeamMember(mbr); err != nil {
+		apiWriteError(a.w, http.StatusConflict, err.Error())
+		return
+	}
+	if a.saved(m.jam.UpdateTeam(tm)) {
+		apiWriteJSON(a.w, http.StatusCreated, newApiTeam(tm, a.isAdmin, "").Members)
+	}
+}
+
+// handleApiDeleteTeamResource handles DELETE /teams/{id}/members/{subid}
+func handleApiDeleteTeamResource(a *apiRequest) {
+	if !a.requireScope(ScopeTeams) {
+		return
+	}
+	tm, err := m.jam.GetTeamById(a.vars["id"])
+	if err != nil {
+		a.notFound(err.Error())
+		return
+	}
+	if a.vars["function"] != "members" {
+		a.notFound("Unknown team resource: " + a.vars["function"])
+		return
+	}
+	if err = tm.RemoveTeamMemberById(a.vars["subid"]); err != nil {
+		a.notFound(err.Error())
+		return
+	}
+	if a.saved(m.jam.UpdateTeam(tm)) {
+		a.w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func handleApiGames(a *apiRequest) {
+	ret := []*apiGame{}
+	for i := range m.jam.Teams {
+		ret = append(ret, newApiGame(m.jam.Teams[i].Game, ""))
+	}
+	a.ok(ret)
+}
+
+func handleApiVotes(a *apiRequest) {
+	if !a.requireScope(ScopeAdmin) {
+		return
+	}
+	ret := []apiVote{}
+	for _, vt := range m.jam.Votes {
+		ret = append(ret, newApiVote(&vt))
+	}
+	a.ok(ret)
+}
+
+func handleApiResults(a *apiRequest) {
+	if !a.requireScope(ScopeResults) {
+		return
+	}
+	res := apiResults{
+		TallyMethod: m.jam.TallyMethod,
+		Results:     newApiRankings(m.jam.GetResults()),
+		Categories:  []apiCategoryResults{},
+	}
+	for i := range m.jam.Categories {
+		cat := &m.jam.Categories[i]
+		res.Categories = append(res.Categories, apiCategoryResults{
+			apiCategory: apiCategory{UUID: cat.UUID, Name: cat.Name},
+			Results:     newApiRankings(m.jam.GetCategoryResults(cat)),
+		})
+	}
+	if len(m.jam.JudgeCriteria) > 0 {
+		for _, cs := range m.jam.GetCombinedStandings() {
+			res.Combined = append(res.Combined, apiCombinedStanding{
+				Rank:         cs.Rank,
+				Team:         newApiTeamRef(&cs.Team),
+				PublicRank:   cs.PublicRank,
+				JudgeAverage: cs.JudgeAverage,
+				Combined:     cs.Combined,
+			})
+		}
+	}
+	a.ok(res)
+}
+
+func handleApiArchive(a *apiRequest) {
+	ret := []apiArchivedJam{}
+	for i := range m.archive.Jams {
+		aj := newApiArchivedJam(&m.archive.Jams[i], false)
+		ret = append(ret, aj)
+	}
+	a.ok(ret)
+}
+
+func handleApiArchivedJam(a *apiRequest) {
+	for i := range m.archive.Jams {
+		if m.archive.Jams[i].UUID == a.vars["id"] {
+			a.ok(newApiArchivedJam(&m.archive.Jams[i], true))
+			return
+		}
+	}
+	a.notFound("Couldn't find requested archived jam")
+}
+
+/**
+ * Conversions from the model to the API's JSON types
+ */
+
+func newApiJam(gj *Gamejam) apiJam {
+	ret := apiJam{
+		UUID:          gj.UUID,
+		Name:          gj.Name,
+		TallyMethod:   gj.TallyMethod,
+		TieBreaks:     append([]string{}, gj.TieBreaks...),
+		Categories:    []apiCategory{},
+		JudgeCriteria: append([]string{}, gj.JudgeCriteria...),
+		JudgeWeight:   gj.JudgeWeight,
+		VotingOpens:   formatWindowTime(gj.VotingOpens),
+		VotingCloses:  formatWindowTime(gj.VotingCloses),
+		Teams:         len(gj.Teams),
+		Votes:         len(gj.Votes),
+	}
+	if !gj.Date.IsZero() {
+		ret.Date = gj.Date.Format(time.RFC3339)
+	}
+	for _, cat := range gj.Categories {
+		ret.Categories = append(ret.Categories, apiCategory{UUID: cat.UUID, Name: cat.Name})
+	}
+	return ret
+}
+
+// newApiTeam converts a team, admins get every member with their contact
+// details, anyone else only gets the members that asked to be listed
+// urlPrefix goes in front of the screenshot URLs, see newApiGame
+func newApiTeam(tm *Team, isAdmin bool, urlPrefix string) apiTeam {
+	ret := apiTeam{UUID: tm.UUID, Name: tm.Name, Members: []apiMember{}}
+	for _, mbr := range tm.Members {
+		if !isAdmin && !mbr.Public {
+			continue
+		}
+		am := apiMember{UUID: mbr.UUID, Name: mbr.Name, Public: mbr.Public}
+		if isAdmin {
+			am.SlackId = mbr.SlackId
+			am.Twitter = mbr.Twitter
+			am.Email = mbr.Email
+		}
+		ret.Members = append(ret.Members, am)
+	}
+	ret.Game = newApiGame(tm.Game, urlPrefix)
+	if ret.Game.TeamId == "" {
+		ret.Game.TeamId = tm.UUID
+	}
+	return ret
+}
+
+// newApiGame converts a game, urlPrefix goes in front of the screenshot
+// URLs: "" for the current jam, "/jams/<id>" for an archived one
+func newApiGame(gm *Game, urlPrefix string) *apiGame {
+	ret := &apiGame{Screenshots: []apiScreenshot{}}
+	if gm == nil {
+		return ret
+	}
+	ret.TeamId = gm.TeamId
+	ret.Name = gm.Name
+	ret.Link = gm.Link
+	ret.Description = gm.Description
+	ret.Framework = gm.Framework
+	for _, ss := range gm.Screenshots {
+		ret.Screenshots = append(ret.Screenshots, apiScreenshot{
+			UUID:         ss.UUID,
+			Description:  ss.Description,
+			Filetype:     ss.Filetype,
+			ImageURL:     urlPrefix + "/image/" + gm.TeamId + "/" + ss.UUID,
+			ThumbnailURL: urlPrefix + "/thumbnail/" + gm.TeamId + "/" + ss.UUID,
+		})
+	}
+	return ret
+}
+
+func newApiVote(vt *Vote) apiVote {
+	ret := apiVote{
+		ClientId:    vt.ClientId,
+		Timestamp:   vt.Timestamp,
+		Choices:     []string{},
+		VoterStatus: vt.VoterStatus,
+		Discovery:   vt.Discovery,
+	}
+	for _, ch := range vt.Choices {
+		ret.Choices = append(ret.Choices, ch.Team)
+	}
+	for catId, chcs := range vt.CategoryChoices {
+		if ret.CategoryChoices == nil {
+			ret.CategoryChoices = make(map[string][]string)
+		}
+		for _, ch := range chcs {
+			ret.CategoryChoices[catId] = append(ret.CategoryChoices[catId], ch.Team)
+		}
+	}
+	return ret
+}
+
+func newApiTeamRef(tm *Team) apiTeamRef {
+	ret := apiTeamRef{UUID: tm.UUID, Name: tm.Name}
+	if tm.Game != nil {
+		ret.GameName = tm.Game.Name
+	}
+	return ret
+}
+
+func newApiRankings(rankings []Ranking) []apiRanking {
+	ret := []apiRanking{}
+	for _, r := range rankings {
+		ar := apiRanking{Rank: r.Rank, TieBreak: r.TieBreak, Teams: []apiTeamRef{}}
+		for i := range r.Teams {
+			ar.Teams = append(ar.Teams, newApiTeamRef(&r.Teams[i]))
+		}
+		ret = append(ret, ar)
+	}
+	return ret
+}
+
+// newApiArchivedRankings groups flattened archive rankings back by place
+func newApiArchivedRankings(aj *ArchivedGamejam, uuids []string, places []int, tiebreaks []string) []apiRanking {
+	ret := []apiRanking{}
+	for i, tmId := range uuids {
+		ref := apiTeamRef{UUID: tmId}
+		for k := range aj.Teams {
+			if aj.Teams[k].UUID == tmId {
+				ref = newApiTeamRef(&aj.Teams[k])
+				break
+			}
+		}
+		place := i + 1
+		if i < len(places) {
+			place = places[i]
+		}
+		if len(ret) > 0 && ret[len(ret)-1].Rank == place {
+			ret[len(ret)-1].Teams = append(ret[len(ret)-1].Teams, ref)
+			continue
+		}
+		ar := apiRanking{Rank: place, Teams: []apiTeamRef{ref}}
+		if i < len(tiebreaks) {
+			ar.TieBreak = tiebreaks[i]
+		}
+		ret = append(ret, ar)
+	}
+	return ret
+}
+
+// newApiArchivedJam converts an archived jam, teams are only included if withTeams is set
+// Only the members that asked to be listed are included, and never with their
+// contact details
+func newApiArchivedJam(aj *ArchivedGamejam, withTeams bool) apiArchivedJam {
+	ret := apiArchivedJam{
+		UUID:        aj.UUID,
+		Name:        aj.Name,
+		TallyMethod: aj.TallyMethod,
+		Results:     newApiArchivedRankings(aj, aj.Rankings, aj.RankPlaces, aj.RankTieBreaks),
+		Categories:  []apiCategoryResults{},
+	}
+	if !aj.Date.IsZero() {
+		ret.Date = aj.Date.Format(time.RFC3339)
+	}
+	for _, cat := range aj.Categories {
+		ret.Categories = append(ret.Categories, apiCategoryResults{
+			apiCategory: apiCategory{UUID: cat.UUID, Name: cat.Name},
+			Results:     newApiArchivedRankings(aj, cat.Rankings, cat.RankPlaces, cat.RankTieBreaks),
+		})
+	}
+	if withTeams {
+		ret.Teams = []apiTeam{}
+		for i := range aj.Teams {
+			ret.Teams = append(ret.Teams, newApiTeam(&aj.Teams[i], false, "/jams/"+aj.UUID))
+		}
+	}
+	return ret
+}
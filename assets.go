@@ -192,13 +192,15 @@ var _escData = map[string]*_escFile{
 
 	"/assets/css/admin.css": {
 		local:   "assets/css/admin.css",
-		size:    252,
-		modtime: 1570465672,
+		size:    779,
+		modtime: 1792301151,
 		compressed: `
-H4sIAAAAAAAC/2TOTWrEMAwF4L1PIcjaoWnpIs42F/FfXVHbMrJdAkPuPiSBgSHbp096cvg/GmqNkqxF
-Ww8PAZA0B8zyyhVM32VbxC4EpjC2355M1hhPaTtXYgWFMDfPpzpOsq89tiot5aYxez61IXaeFUxlg0oR
-HQzzPC+viWTtsFcFX0chgNH2LzD17KSlePQM67ou9w8/P66Fop3DHGT0P+2evutdPAMAAP//BQCMs/wA
-AAA=
+H4sIAAAAAAAC/4ySz47iMAyH730KS5yD+LO72pYjXd4jbUyxSJModoEdxLuPGhgYoIy4te73/WzZNbQb
+V17Et4qDrhGOGUCrY0NOnesFTH+HwyI7ZRm1zVg2XVs5TTaRdRfZxwKCJycYE9VHRuTOCqvaO9HkMCa6
+8tFgLGAaDsDekoFRnueL6xcVtaGOC5j3DQEqXW+b6DtnVO1t32dUluXiecLZ5CwEbQy5Rllcy3P1nj5l
+mejK4jhointiVOl1aAE/C2JulT05OA5Pvvz7788yfy/GeuZXOavVsixn7+Uw2vWrnLT5y7lYdIPKEAer
+/ydB8CBKW2pcATXe3fYe3kwTv/ZOFNMHFvAL24ETTR78nRfkR3U+qF7Xf7Mv/9ejP8P2ClrafXGXZ+22
+6tu0e6RmIwVU3pre+hwAAvZd9AsDAAA=
 `,
 	},
 
@@ -243,34 +245,34 @@ d/XtFvKdSsxaxXsb/D8AAP//uPq2fRcVAAA=
 
 	"/assets/css/gjvote.css": {
 		local:   "assets/css/gjvote.css",
-		size:    5005,
-		modtime: 1601643451,
+		size:    5093,
+		modtime: 1792304015,
 		compressed: `
-H4sIAAAAAAAC/7RX3WvcOBB/379CEApJqdzdNNskDoSDfnAPd099LH2QLa13iCwZWd5sWvK/H/rySra8
-PQ6uNLCWRqP5+M1vRnvdcvRrhVALAu8ZNHtdos16/eZh9bpaVZK+2N1acqlKdHF7e/uwJEyspGZHjSmr
-pSIapCiRkILZfQqHopZCM6GtZEcoBdGUaLPtjnmt48UVJ/XTqGVP+M6qeAaq9yXaeguKPSOUKecPUQ0I
-zNlOl2jttjtOQCT+rO0/v6ugJeplsn97x26TfVw1VqQi9VOj5CAozkkzpaRKdH3+fLrL7p7RFMkah2Vn
-Ykn4DhinkXslumatlVqB6AZd7ICzNLjFlrWo+GjEEKLQd5y8lAgEB8FwxaUJK0KVVJSpEm26I+olB4ou
-6rp2O0fc7wmVz+ZUzzRaW6kP3RFdUEpPp7EiFIa+RDcmna/BJEXEE4gG2680ay7tk9PbeDWx6NOnTw8B
-YYRDI0pUM6GZclgdtJai6IeqBY0PUptAGCuKVh4Yhlr61A+qNzEWPVash58OnBctE0NsXQBgAOSNt7aT
-PThgK8aJhgN7SFJYoovN/eZuc2eWn1n1BBrLA1M7Lp9xXyvJuc2LlkO9j64uukExbH5ig2IQDhsLGcsd
-E9KIPOXqNUXDZgKGEQULtYvQ8x40w31HamYWnxXpYhvs9S7+OaPTsJGql3zQdlXLrkQ+sMrF2X/58rUC
-m3RNBYZwq95Va+LDWQLydkqtZZukeoJEd+mIO6954q5hMgLCs83ocYhY3t/UR3tPwFWGBAKOlm5+HPgS
-f0RHLWFKBT/NMe4haM+NsDyWyC27sm2bQu+HthIEnGBEyiETxxlTm7WkdkzERz1L8RqxN1WXcerLly8R
-mF2arteebAq/nLLfNuxa7CbMmW7hHijr551jm4Pe5OiBKQ014fFpa1x62AEvOs2JapxNOyk0NlxUos1d
-2K6gwXOzN6PDxsBTy/WEaBbNdmFNnW3bVbvvmHMmEBGqF8HEwyUnhlBwfr4TLCaDllahJhVn4WJtZotH
-Tc9Z4A6ExHrZWW6tFNI7KZ2/+Sp23cMCxTCmYyt86tJnmvCJTr9+/TrXoWXTcIZ3oPqpAQ4wY2tLacbh
-ISfymr2gBUp9d5+0zJQfHXBx0kf94jwcuYs4mTtizp/3IyfxXyxKTCop9Ca9dClHfiYIn/f3954vJSUc
-j/PrpAQ3ATtezjAhJ070AD1UwEG/lGgPlDKxzOd+uozo3P9eGCLCd76UfmIQlB2t2DrPgaqpyOX6HfL/
-i+1Vxo1HCocJZ7wxf5Fhd4Gzg8C6O/pCzYbZAj87lAVTU0SG5jyb8RcqncLhgrUVNA0TjH6rFWPi294X
-dJjYOgkhVEsmnlH1CG3zK+lTcefyGRo7l+mau4Hz3p6fdsE3mRz/fsJJ0ZKFQMyZYz4CLjbr9SaqkJ1U
-LbJT9fdQJT9KIfXld/3SsR9XRVg1tYQtrAN9LCY4fG63W3OTm+Btn1LxxHSzDl3oj5ZRIOjSvN3GTdZe
-WenTUD2ftebxjEO4gyOzT4vX1ahnNmZmxq6Z+Dh2TA6AAA2EPyS2eUOsktmwmHMhY/AkzaYyHOf4Bac8
-eqhOWHLUbgUtDDnp9140GhSub85aEQMv7+GoO3qr/qYPJp3QQ2aiqx/qmvX9srbr63uyu1/W9rpavX+L
-vrVm7HDV26O37wPQfD0SQdHlqZI/bM3D5sqPlgdXHyb1SnJs7u8QJxXjRVizX97G+fhkzECokIK/4NOQ
-loVbEOvbMCZFYpTtyMBPCjUjLW6JIA1rmdAey/3cjsSMhVOPcZ9MkeRGH/fKGwOqidJmjCLaBfcdOr0K
-lgJ8Kur/McLZ0J0iHOPhz5PFWqK/GYWh/fe2T0CDbu6sR8jd4LX9BnIRzd1ldH68iVX+5YhzrjLWEx35
-JwAA//+TwLU9jRMAAA==
+H4sIAAAAAAAC/7RX3WvcuhJ/379CEAppqdx1mm0SB8KFfnAf7n3qY+mDbMneIbJkZHmzacj/ftCXV/LH
+5nDglAbW0szoNzM/zYz2uuXoZYNQCwLvGTR7XaB8u313v3ndbEpJn+1uJblUBbq4ubm5XxMmVlKzo8aU
+VVIRDVIUSEjB7D6FQ1ZJoZnQVrIjlIJoCpTvuuOy1fHgkpPqcbSyJ7y2Jp6A6n2Bdh5BtmeEMuX8IaoB
+gTmrdYG2brvjBETiz9b+87sKWqKeJ/s3t+wm2cdlY0VKUj02Sg6C4iVpppRUia1v305n2d0zliJZ47Ds
+TCwJr4FxGrlXoCvWWqkNiG7QWQ2cpcHNdqxF2RcjhhCFvuPkuUAgOAiGSy5NWBEqpaJMFSjvjqiXHCi6
+qKrK7RxxvydUPhmtnmm0tVKfuyO6oJSetLEiFIa+QNcmna8BkiLiEUSD7Veate64oL3rjiuIvn79eh8Y
+Rjg0okAVE5opx9VBaymyfihb0PggtQmEQZG18sAwVNKnflC9ibHosWI9/HHkvGiZGGJ0gYCBkNcebSd7
+cMRWjBMNB3afpLBAF/ldfpvfmuUnVj6CxvLAVM3lE+4rJTm3edFyqPbR0Vk3KIbNT2xYDMJxYyVjS2pC
+GpHHpfuasiGfkGFkwcrdRehpD5rhviMVM4tPinQxBnu8i/8S6DRspOwlH7Rd1bIrkA+scnH2X/76WoE8
+XVOhQrhV76qFeH+2AHmcUmvZJqmeMNEdOvLOW564ayoZAcFU6rGQ5/xNfbTnBF4tFIHAo7WTHwaOXt5U
+tQVTKvhj1LinoNUbaXkskFu2CtA2md4PbSkIOMGoKIdMHGeV2qwld8dEfLSzFq+Re1NzC059//49IrNL
+09XWF5vML6fVbxd2LXeTyplu4R4o6+edY7dEvYnqgSkNFeGxtgWXKjviRdqcqMZhqqXQ2NSiAuW3YbuE
+Bs9h56PDBiB6mRREs2i2Mwt1tm1X7b6rnDOBqKB6EUw4XxVDKDg/3wmIyaBlSBEok7lK0sjvmrTAnwvU
+SiGtv/dpRD67csWZNmiMhE3tNgu9T5OSs+CQNjPLg6bnPHMKgTBedsYZK4V0LaWL43J1cF3JemcqsauC
++NT9zzT3U5n+8ePH3IaWTcMZrkH1UwCOiGPLTMuX49mSyOviAS1Q6qeGSStO6667EDjpz35xHo6lgziZ
+O2L0z/uxJPFPECWQCgq9SS9dy5FVOiXo7u7O12FJCcfjXDy52nngjpczFZYTJ3qAHkrgoJ8LtAdKmVjv
+E35qjdqE/70ynITv5Sv6B4Og7GjFtsu1VTUludx+RP5/tnu/4MYDhcOkFr0zfxGw29ALgsC2O/oCsBhm
+S/zFYS9ATRkZmv7s7bBy0ykcLlhbQtMwwejPSjEmfu6lTibBTkII1RrEM6YeoG1ekv4Xd0SfobEjmm5c
+D5z3Vn/aXd8t5PjtySllyyIF4lo85iPwIt9u8+iG1FK1yE7rv8It+V0IqS9/6eeO/X6fhVVzl7CldSgf
+qwkOn7vdzpzkXga2/6l4Ervehu72n5ZRIOjSvAnHTda+t9KnYX0+w83jGYewhiOzT5bXzWhnNr4ujHMz
+8XGcmSiAAA2E3yfYPBBrZDaELrmwAHiSZoSciXHBGY8ewJMqme9iQUtDTvq9F43a7dX1WRQx8ZY9HG1H
+b+A3+mDSCT1lJrb6oapY369bu7q6I/XdurXXzebTB/SzNeOMu709+vApEM3fRyIoujzd5M+7bBdIZ6DY
++2FSryTH5vwOcVIynoU1++UxzscyAwOhTAr+jE/D3yLdgljfhvErEqOsJgM/GdSMtLglgjSsZUJ7Lvdz
+HAmMFa2HuE+mTHKjD2uTgGqitBmjiHbB/YhOr421AJ8u9b8Y4cXQnSIc8+G/J8Raov8zCkP797FPSIOu
+b61HyJ3grb1BORCp9sTml+vY5P9c4ZybjO1EKn8NADKJD/TlEwAA
 `,
 	},
 
@@ -3383,6 +3385,30 @@ DwtfXuOfUN5KuU5PE7iCRbS4bruiN8+m41lxOmn8Nf43AAD//5TFLD0iDwAA
 `,
 	},
 
+	"/assets/js/live.js": {
+		local:   "assets/js/live.js",
+		size:    2395,
+		modtime: 1792301138,
+		compressed: `
+H4sIAAAAAAAC/5RVTY/bNhC9+1e87iGU4F25vcbVISiCom2wAbq5LRYLWhqvuKFIl6SsGI3/ezGkbMkf
+2CI3i3zzZt6b4XixwF9EG4/QELY2ECrbmQBpavggTa3Mi4c18X4jXwhV5xyZMFss8FFTSyZ49Co0kKhl
+kHdabQkyBKdWXSBIR1grramGMlg720Yq2sZAPzCtdljIulVmkS7e8yFGwvKGa/M3APClIZiuXZGDXcea
+/QU6kGyvoeP5JVpqvRvRsqWIbQjxBi2FxtYXYY58p4O/SWFHt27haNUpHWAN63S7pPYs/q61NZU3v3De
+z0bv4BvbG/SN0pTc7lZaVWAYWhmqhvwsW3emCsqaLMe/M0Cts596ZWrbFx85x4PtXEXpDnAUOmeWM2A/
+A7bSwbsKJQz1mMAzceK9yDnCu6qwpiXvuekljolpGw78kTHI0Pll/A5uN9xgOEeJPx8+3xcb6TxxaMH6
+8wTfo2JZ2bHeacWpZsBT+KS29IW+hUzEZovbgbzgz+c4r/nyEhx7PYL58w0wN3oC5s/n1Pd8eRTLvfio
+WVVtq45nv/inI7d7IE1VsO6D1pl4PG3xkxgI1tZlTKJQ4uclFH498BWazEtollDz+eiFWmfD/aN6Kl4o
+fDg8qkycphA5yvJQOh+MJMCEo9LS+0/Kh8JRa7eUiUbVNZlDhcAepD39X7Ss6yuhk6axTEf+B6waX9Nb
+fiXO63bxk6v/TiRZAj6qp2NHB3p8/47Hp3ycsP1sn2f5bHaY75Ox4FVwi63U3WAp10M/okrMmWMuDrKu
+iKLriii5rowhx8WgTIWk57yfxbU50QxHGy0rSqu8sibEDWvXIJ0WtDUEZ3tsyMVFyAx/xOtG+sliepEt
++dtIwzDhwSdpLyo/7Cnp0ZPWo28n/pPmLRh/j8Zx4O/MjRKki0b6qxMd0ye34jLMSBdr5Xz4rVG6Hu0Z
+hjienmEOK+/6CHFV1xw/oF8T+nVEcyPiOjmGvY5hSVxoUV7AH1+flhMMmz+ZnMqRDDT8iWaiVtvxNTnb
+pwd3z66XENGaxI/0W5qvd2I+Sckn03QhDs0ZYC7eQ8xDW3A7l+OqGZvz7h1CW3AXnhkzykRknJcQuEsc
+R9DpEkjly82GTJ3ac6aZ5/ne1pSFbyHPJ1VMDVT0vHIkv04LiLJWb5hI7eghEFYn70cgW+0g5teyzEUu
+xsDz+sMqP5dI+gThbH+6VWb/DQBNiyljWwkAAA==
+`,
+	},
+
 	"/assets/vendor/css/grids-responsive-min.css": {
 		local:   "assets/vendor/css/grids-responsive-min.css",
 		size:    8032,
@@ -7956,17 +7982,15 @@ kjFi/csiwfr3FfKgrfPT4dDtCD4I678CAAD//36d2kzwlQAA
 
 	"/templates/admin-activateclient.html": {
 		local:   "templates/admin-activateclient.html",
-		size:    1355,
-		modtime: 1570465672,
+		size:    946,
+		modtime: 1792304029,
 		compressed: `
-H4sIAAAAAAAC/7RUTWvcMBC951cMc3cEORbbEJoWcmkXkj8wtmbXAlkS0sjtEvLfiz/Wu16SUmhysWeG
-p3nvjQaV2gzQWkqpwpadcMT6BqDc+9if6iFHLqbCGhVkzcGxRqBWjHcVKtK9caq1hp0k9fICt8/cB0vC
-DyR0+6jh9VVRlg6hZ+m8rnD38+l5ohsJDVudWOYUoOzu6q9TM3h0IyWNPKXq7lbEhfRJWOudRG+LQ/Q5
-4AkGUFpq2K7dHr6Uaq6cEcaFLKdmluKBI2iTqLGsiyZLMZhkGssIA9nMFb7tD9dDFZ4iBLUqVtoM/yt/
-9znyw+fKh72PFc7b4ahnrL9Hw07bI/ygnv/JEoLRmx4wfrcVOYarynuOR9rJc7DUcuet5ljhRhVeOb9Y
-zftx2z9oM+fZcE/GYv1t/MG91pFTem8s4xxm/DKCJZndC/+WK1ubrgiUxe99m9PHXW2glH75qLHeLdHf
-xK/oRf85ny2c842N3Ury9sU0WcS7pUfKTW8EN04WwEVchGh6ikdIgVouBo5iWrJYP03HSzXDlldKXT5T
-pRpvv75ZRPwJAAD//xQX50tLBQAA
+H4sIAAAAAAAC/7RTzWrcMBC+5ymGuTuCHIvtS0MhlzaQvMDYmqwHxpKQR4Yl5N2LvWt3N7RQCHsxo49P
+34/R1F5m6JWmqcGeg3HG9g6gfot53PBUMlcrsE8VqRwCewTqTWJo0JEfJbhehYNN7v0d7l95TErGj2R0
+/+Th48NRsQFhZBuib/D518vrarcYCquf2E5HgHp4aL+vYvAUFktafGo3POyMi+hrsD4Gy1GrQ44l4UYD
+qJU61l3t8VvtTsgfhoRUbBNTygfO4GWiTtlXXbFqlkk6ZYSZtHCDf++H+6UGtwnB7Ymdl/mr8Z9vEz/d
+Nj68xdzg6XUEGhnbH1k4eD3CTxr5vyohiL/SgOV7jdgxfUL+1XixXTsnpZ6HqJ5zg1ep8FPz7dQVsxjO
+XlPpRjG8+hdnwsVcpSwj5SNMiXquZs4mPSm2L+v12p1o52Vwl9tQu+X5t3fnEL8HAKYI8PWyAwAA
 `,
 	},
 
@@ -8012,91 +8036,124 @@ NeurW5vrS2g3c+5HAAAA//8MM1iJSgMAAA==
 
 	"/templates/admin-archive.html": {
 		local:   "templates/admin-archive.html",
-		size:    1315,
-		modtime: 1571431270,
+		size:    2737,
+		modtime: 1792303022,
 		compressed: `
-H4sIAAAAAAAC/3xTwW6cMBC98xUjK4dEysKdeKmi5NJIjXpIDlXbw4BnwSnYyDastoh/rwyG3c2m2cPi
-8Yzfm3l+5kL2UNRo7ZbZFgtiWQTAEaTYstype1NUsqcnbNhS1naGNnnnnFZwst7YrijIWgZaFbUs/mzZ
-G/ZoCyNbl9pK7x+02knTBMhvWmB9fXPHMi4X6L+NkOD/Nrtat+2BZTyRGYQT8NAZQ8rBEzY8wSziiZB9
-Fg0DyB0o7SB+oaat0dEjOoyfsLEwjpGfMXvWMMUV9gQ5kQKcUUV8hKHa0nrC6+Awr2nSIlRvpp1VC6vN
-XDIJ8X65ybURZEhAQcqRmbT1qBWhmNdzbI5BKMiesSGeuOoyc77Lk+W0319xucu1OBzLhgEMqpLgSt7C
-VQ/p9mOx4LOuRDYMcNXHvjcYR544cVGyKKNVfdjUaEpiGUeoDO22LEHRSJUELZMZ7fX16yOMI8vOQn/B
-5wTHScNApIS/rJBb5uXJpP2pO0JhxGczZtGuU4WTWsF/bQlDBFN2joeJxVOkwO4NwUF3YDtD4Cp0U7RH
-5cDpxVbgKoIiGLb0gr1h8+WXeqmkhQKVt2tO0CmhFcXsdiaYXpJNfw7rnE66mlJgP8iGIv+bHlgKzsiy
-JBNaP02jtSmcPtUNGaPNCUSrrfQapMCMLCu3psZbuKR/1pfslRSzXB+C1rRbMMff/jve3EXjUfrz3oPi
-K+T1zWf6+yNSlauuMXyvCS3BHqWLWSAD2Esl9D6udYGeM/YuhC289+HytsN1Md8nTxaz/AsAAP//gPxv
-GCMFAAA=
+H4sIAAAAAAAC/4xWTW/jNhO++1cMiADZBSwp7wJvD46kYpukQAI0XaC7h34dxuLYYpYiBZKy1zH03wuR
+kizHTpBLQg3JZ575eIZOudhAIdHajNkaC2L5DCBFEDxjS6c+m6IUG3rAig3H6sZQtGyc0wom68g2RUHW
+MtCqkKL4nrEn3KAtjKjdwpZ6e6PVSpiqh/xNc5QfPl6zPBUD9HPFBXR/opXUdb1jeZqIHPobcNMYQ8rB
+A1ZpgsdE737U2rhXeDIoDa0yliCvhEow4CVFwEvI3z1PhOutkhp5TyW4eZPJffUmk9eyE669nZWmnlAJ
+F0YKacLFJp/t9yBWoLSD+CtVtURHt+gwfsDKQtvOuoLnjxr8d4kbgiWRgj4lPD7AkLQ03ugidLiU5KPs
+T0feMoZptQlHfLwvl9FSG06GOBSkHBnfaB1qScjDOnybw0d/IH/EitLElac7t+he2XmPNU0Gb2ky4ZG6
+pea7w7H9HgyqNcGFmMPFBhbZ+eTCW1HwfL+Hi03cxQJtmyaOnz3SV+9iE3exxff2LzIa2na/H22/alOh
+A/bp6uqn6Op/0dUnFg6Q4q9BD0XSSu4iiWZNLE/xvC6Cq2/f7m+hbVl+9Nn12nnuKb5feUeQg/7ACScp
+Y3fvluMpmUNF+8KFlAw1HuqaJr4np6rpD/qB2PW48PJ6wmqlTcXAul3HjQtbS9wtlFZ0HWZlnd+UWlsC
+hGWjuCQI8RCHldEVuFJY0AZQaVeSAUtmQ2YOriR4wgqEBeScODjtbX2O4jSpPX7n/yiz3jCuIpRirYgz
+wMIJrU5yHQJhUJErNc/Yl9//+MqAVOF2NWWsaqQTNRqXeDSODgdpTt4G767Qyhkto7XRTT2mpEKzFipa
+aud0tfj/Vf3jmg0lSIWqGzeArEQ3LYLbsFZYUcZC2oIFi4Jql7H4WdRzrGspCuzCSp5FPfBK+onkp27o
+r8kwLQU/PC4H/lJGklYO/CsXWcHJTp8vlt+gKkj24xwgDfaerm2WlXDHcEasy1fxpuuoNqJCs2N5mNlp
+EuyhE7u0j404S0MU+WzVKF9POHkcYD8D2KCBUNluGkAGXBdNRcrFa3J3krrlL7t7/uHyqJEvP8aF1Ioe
+NacPzjT08XoG3kUA3/vYvRAXcHl4Yi7nfqPTT3d1MfE9A2g9ysEU+9aIe7FkbCl18Z1dz9rjqM7+IPDR
+vSTU+V0A+2wIdroB2xgCV6LzX1tUDpwehONF1D/tsMbKq+znf9S9gwIVLAkMWafNQZ+j5qCb6CZmfbC+
+SHbx934cKH1i2J9k2Xy0+gd9Ac6I9ZpMH850G61dwHQsRmSMNhOIWlvR5WUBzLfVuNXO4dT9oz71Prb9
+WdCu9fs77b/zvmSTchxz76swUdJbNemuCLUecx3DF0loCbYoXMzG/tgKxfU2ljooOu6UCxm8nFf9/6gv
+oW+bNBlk8d8Ah0IUMLEKAAA=
+`,
+	},
+
+	"/templates/admin-backups.html": {
+		local:   "templates/admin-backups.html",
+		size:    1465,
+		modtime: 1792303265,
+		compressed: `
+H4sIAAAAAAAC/5RTTW/bOhC8+1csBCPvYkl+wcs7JLQOQRCgKZAWjXsuVuLaYkORArlSqhj+7wX14XzZ
+QOuDQZEz3JnZpZCqhUKj96vI11hQlM0AxMa6CrBgZc0qSlFWyqQ5Fo9N7dPCETJFUBGXVq6ir18e1j0L
+QOQNszXAXU2ryDd5pTiarq8bR/EIeLWOfVMU5H2UCTVBnyupIPzFG23ruosykaoMrrF4hO813NsnkQ7s
+Xm0a5GYzkUrVZrPjlnY7UBtI1lTVGplukDH5ZJhcixr2+xnAuiSQyJijJ1Aegl+S0NRALbku3HCSDpUy
+DZMPNdB0XCqzhRI9FCWaLcnFoIC0p6HYQ1GSbDRJGGMFdAR2s5mQRg5ANBJy2lhHgPATq6AMXVGqliRY
+B448W0dyET4QmAaIJE1MMhl9GXoizx8tfCaqg/zXIh6pZlAGRGElZR8oN8rBfi/S/jQRuYM0C1XsJAWs
+oQV4tjVwSeDJteR6H64xoBieFJfj7fHIiQcBqzPNVwYrOtvy1VRi6uvQQWP5naDrUft+/7H1cUuOVYE6
+ytYlhQwdmX849OjguSNOXkpMLRKMuSZQchWNwLjfOYyzt26A9LP8fhnn1klyJKGgMCbDs+KSUIZV+Al2
+03L4LLO1qkikXL7f/0borTlyMomxRnexRrelKLvDyv8h9EE9H613j291iHTQKtKDA8G5ld0E2O3AhUGH
+uVrAvIXL1ekmnXAvw6jN2yRkkNxaVyFDdIcGfpzD+XL5P/x7cbn873J5EfXjx/I4fYjqOORYBLsdaDKB
+eas0+b8iztskJLimX3xC0+ENzdskhPrycl6jp3gB3r59kY4hi7Qfqmx2OJ39HgBR3CAHuQUAAA==
 `,
 	},
 
 	"/templates/admin-clients.html": {
 		local:   "templates/admin-clients.html",
-		size:    1742,
-		modtime: 1571429152,
+		size:    2250,
+		modtime: 1792304041,
 		compressed: `
-H4sIAAAAAAAC/7RVS6vbPBDd51cMJovvgzqiWRbFcGk2oSXcRe+iy7E1iUVlyUiKm9T4vxf5kWvnURJK
-vZJGc45HZx6qa5A70MbD4hsVpUJPa/S4+Kwkae+gaWZcyAoyhc6tIldiRnFF1ssMVZRsDaAQ0kujUUHW
-g3KsCFIiDXjwOeng7ElwJmSVzOoaSDlqqT2mikCKVdRj49YSnf9nbOdSHizFl8s4NVaQJQEZaU82SmYA
-3OeEIqzCx70dlt02T14OPufM55f2LRZ0wz6EYrQ6xQrtnqKkUwc26wf9v6Lz8EWbnxo2rw9iXqbS3cVE
-yfiQs+7CwdbLwH1qxGlwqGuwqPcEc/kB5hV8Wt3NPNzTUIy3LaXcwbxahJBDWseHHCG3tFtFDEUhNevz
-zOo6IN7eNmtoGiYoVMo57aWVBdpTNP0PAHcl6sHpVyFknGex85j9gGGn9lcoAC7HoM43kzZTBBOWeHkM
-csrHGPIsXh57spxGMXRcH49ng9QVWUc3uTkLl5paOcMriYeeeV7cVtp/KeXVlTs5SoVSP6FnqsyViMsj
-kLXG/p10WkyV42xaw6GkO83CEICm4eWkJV2BSkXJfxNd/+esTG4w3WrmCfApzOb1KcSkEeE7uVHpwNa8
-q/EHyih5tGkV+fdZ3c7l9OC90TBax0MZ3Mh4z9DmNiRuHNQwyS5zyFk/zzhrH4HuQelOfwcAAP//p8mV
-Zc4GAAA=
+H4sIAAAAAAAC/7RVTW/jNhC9+1cMiBxaoArRHAtKwGKDAkGLNEB3Dz2OybFFhCJVcuy11/B/L6gPR7Kd
+NEHRi0AO5z2S781QytgtaIcplSK1qElUCwC1CrEZw+0mUpEDAlCzDb4UEk1jvdTOkuckW7RRQENcB1OK
+pz/+/NKxZB5LziTifgqgHK3Jm+oJbQSEngC+Wa6BawIdDEHwYDlB0pHIKzkgRgLr2w2Dx4ZKkdMF8L6l
+UjDtWEDrUFMdnKFYiryJ9Wv43KXhhoMOTeuIqRRhtRpC2FpGZ79nvhojaqaYBET6e2MjvbJxd+48fmP7
+X6Mlb9weHnPeiWe5YQ5+gKXNsrEsZlIPCZNx0UbbYNyLStkx9XtjLORP8Ux5QdoK8oWV7DGDAXLqgJLZ
+xmqhpLHbanE4gF2BDwy3X6hpHTLdI+Pt595WOB4XF+VRbCmy1ehE9RgAjbG5ItANViaocUuwJPJZ3Zp8
+TmYyL1uSS9RRMy4dgTWjnKnoIicxUoh9SqfE+bBYhmgokgFNnin2dcs14YtlHMdhP62rTxuuleT6PJ4t
+uhIfjxK82xcO45pE1asDD/fvzP8dE8NvPnzz8PD0TsynuXSvYkQ1XVSyv7CSJxkUL4PZjwmHA0T0a4Ib
++xPcbOGX8lXn4TUNzXTaUdoV3Gxv85GzrdNFhVBHWl28F4dDRnz9+nAPx6M0lCvlpQfGWp9RAajUop9W
+f1HrIjHqZxhnbn2BArjWMtpG7QhmLMXdrm+jdzHUurjbDWQ16eczrp93p4D1W4qJrnIrmS81jyqJFxKP
+PfNxcTtp/08pL67cy9E6tP4Dei5duBDxbgcUY4j/TTpv5sopyea8pHvN8iMAx6NqZy2ZGnROVD/MdP1R
+yba6wnStmWfAD2Eenj6EmDUi/EVpUjrwGF7UeINSVO9t2vwf/fcf11AGVxwfGDpvs3HTQ40v2bmHSg7v
+mZLdT6BanFYX/wwAcDuXmMoIAAA=
 `,
 	},
 
 	"/templates/admin-editteam.html": {
 		local:   "templates/admin-editteam.html",
-		size:    14148,
-		modtime: 1571429152,
+		size:    14937,
+		modtime: 1792304428,
 		compressed: `
-H4sIAAAAAAAC/+xbXY/bNta+z6/gqzeAbOzY6iJtLvy1TTJJECDpLjqTi0XbC1o8lrhDkQJJeTI1/N8X
-JCWZkiVbM5kkKDa9mNoUeb74PIfnUM5uh54WBSVotkTTa8hyhjVcYo2nHz++u0T7/ZMFoVsUM6zUMoiB
-a5DB6glC/rAGnE0yzHECGXA9WRdaC67sPIQW6bPVNeAMfahnLKL0WfnQzUWULAMgVE+sLDcYIMFjRuOb
-ZfAfvMUqljTXs0RoYcRd4/VoPA8qG/JCQrluokWSMJhsqFQaeQ/8z5Nc0gzLu8DZdgkaU6YWkXu6Wqxl
-JVlwdjdRGWYsQFGP1QnOYJDVb3EG5y3PKCEMfHODlVn5uWa6jYJsDVINMveDnXveYIabkS6j6pYPM3cR
-EbqtkdU0WeN1rZ3BRlfI2giZNayyA/WnCWY04UAChGNNBV8GESYZ5ZERqqIa+/t9pPAWApSBTgVZBv/6
-59V1qcPDbx38CrzGAgqMKNDVQJMYxla0pslE5TiG4DCpOc0aHAuupWCTRIoib0xFaMHwGljNwnKmHQzQ
-RkhHQY4zKAP/C85gEdkJLUmU54W24a2XIPPX/77FrIBlsNu1UoKRivb7AOUMx5AKRkAug1ph079qO7u/
-nvQenU4opQiMUgmb5p52YbTJeoYpD1avMI+BLSLckFjO13c5LANVrDOqzwus0sjHnGANyESjxnuv8Ip7
-Zh/Wml8CAw1m6Vl9IKWQwcqt6NHWCPYiamJ0ERlqeIw7opxNZm3KHWCMUkoI8M/loNEynIMdPMMlbhOL
-2ch7UhH2rWXBga33Yt15zhnFjnM2N/dwzmNcvcCz/DTjjOAe2tU6gwey7AHeMspvgtV7ym/OO2rneo66
-7ycdNYJ7HH1vNX81RzcSZ3Ar5E2welN9jF7zhPIBW3xY7LnvDZ6MQa2uJxBtc75iUAio2GQeVyNQwbtC
-oeGTxhJwHQ27yguE+95wzBM5nU6DVXdkvFlov19ElaaHHjISFOhJXjB2/lzx85SXohmb2Nzo8uIXOHMY
-m0iapGc1tA4hl/geeCwMRs4p1KyuYgnAVSq0asHkuKGYYMZQKiT9U3CN2UTFUjCGdFpka44ps/ox5SDd
-idn1oJK+2yG6QVzoLgh5RpnWxttype8YLIMMy4Saqjaf/fhD/ulQ83qGntuEEj5eNe2K5I85E5hcXb0R
-MjPl9MoNoINRHjZ2OwRMgW/lbock5gmgp/QCPd0ed2wnXaRZggjW2BbUJogNRNsnSlXj26r7q/2vQx4g
-zHQ1q0nJACkZLwMja0YznEC02z3dTt9QBgbe+/18jRU8//HCLb6uRNql3vltfOfkYH37ewPHbr+HBsLH
-niVW0CgoLLiONuul5oPLwEfZ/LaDnveHLqmneGu0eEdFXLN0qxvzqk2ru3KN1wya2Ec5JoTyxMg4nDoL
-nQImXhC1bB4H6crVRTptjTcaQYZlAsHqiuH4Br27HDj9+pZqDXLg7NcZpqxj7upXyMS2ZeIiOjhinnhO
-LvRakLvVcF6W4W3QsR0msnKkKGu9RaRJa0KXS26Njdo7ct9lZfTuu8yGsXtRq9W0jcDZzpvYTsYhtqf6
-b9VZ7qwsoVxWFm49JX515aWx6EjUQ3q9svda0GrinxmhyPyZOC+C1SKiq672r3nA1rgi3Yg7znldkEGx
-YCrHfBk8D1YvCEEYcbhFLhSnhJ8WVUfB8T3o2tV2vzfwhmXYLt/nZqRVfnO4dTr8Nqs1WAKkVYM6krqG
-CuFCi42IC4WiYeqUoaBBX0tjPd6t9GdH3ctgqB7tOHukpx7v0XNdPR+oBzJ71Le0lKPdOgoF8mc7YxqL
-rFvTfYrdiaIE1PmS9wUh3YQ76gfuy8JF5GX6RWTPRO/orf5XHb+FPdFVfaA7XpSFJaEqZ/huxgWHubtA
-T591FQHuCH7MW5VauDOwxT4EPHb7kRVM0xxLbUM0MWVcMKgfaBXPa6G1yGY/2fq5jqUDWSlkQxkEJQzc
-5xppSrkBZ40LeGMn6/7Mq7FMSfJBEMya19SN9uwYT0ct2dfAZwXANnpM8TYcO41Vyu+PHr5NLVi70JoH
-iuP4Zsqo0sBBjmyodlwQmBERFxlwPU1Av2b2rvbl3TsyCht3muH4ArbA9Sy0LxrC/YUVsSm4xe5ojHYl
-SlQqbt0+7mpGaqoZzFDo3XiGF/VTVayrCaO+++qxN98QeobCFxLQnSiQKiT8A12nVKEYc9M1rgEVnAgO
-U3+Vu3+eod92XuZwikMHIm82QrlQ1Lg2Cw3+Go9sCGaoRmz9aH/RIds53SPbgrElHCs1Q+FR0dKYZegz
-Q+GZiiw8WPZH+XE/nttPpiixHzuRUU4+DZCOFj4cV0Y20FINGqsSrGF2AI5RcQAPQhJ0IbnV7ClUL++u
-cWKwMApploSlE84NE/dTaHxNqHbp+SoV2mHTeX0rcT7SKVXj3374oxmYJwdxvUJollSajlFfQdos9E6H
-OhYGxb+Y+CKaJdOYCQ7m60jLAuooHkB7qCpbbAovTkHmADXUiTUL5I64uf9uKSfidspEjG0glmjIGeWw
-FwV/M46ZI0iBnipFydwTvvdI0+FbRcgu3zq9OiJlrcEh3+F+39jXrra+jADdjGyJpF6lpi8ko/GwDPeR
-mxqZILdMdWe5o1wW/FsU4RZQhgkgJTJAsVt/YRCAUrwFHmrkROsUMnQH+gLRjU2Ahn2UF2Ce3KFbypjJ
-gEwoPQ2G5b8q3h1mD01I56AkYQtSl/JH43njYef9SmOGXx30oKjLrbcCvcReAurzpzzpB2b5o1zq7vkq
-A7ZYIlenGU/QEvVm0K5yMxy3k8F8CO7aVehnnq8uMx28aHmMvEdTW5NMywpnGayZiG+Cal6v75nxZGJ0
-heNSRAqGy8vw789/yj+Fh3TcZG2Ll2XYowi9SiG+MaSo32aXNCIVpfuPsvK9eTieWvHo/5Yo7ItVOEa7
-6pwyGzQvYeib4P/SY7AV1bvE01b47xP7TDmnhVF+M0BL+TLvhBYVy2J9pSXlyWmNBFRcaRwblf7KcMjb
-onA8IO6HyxNEyuib7InWABzZO9AB+9C4bGiE6SHxbl8kPJ7A8sbg8QTa1n+QuHJog5mC44PV31vK8/pQ
-5Tlamr9TCfbeYRT9Ln/nUXKBgqDMLF1zmjNK1ZTnR3pbh8whNfwKCvQhLzw5mZmOksGpXDBvqvB5f1rL
-EdnPcn1+XmCD12dpPUCgT9t+gS2itmLicdK9rzyttpt9BovzgQvbRLvP2jan7rO2SZ9qZROijZ8+fi82
-v0Gx2fzx6V+2yuyFY/snuLagxEq9p0pPMSGjTpvG80FyvR/JNuRK+/7ts0R3/LD1i6jQeN0p172Mulcg
-2qJsdO8np/2u95y8fW9GqX+W/D2rfMOscvhx+P9YZnkEevYll89NWt8qszwgHXSmlYdlqMfLLN6/IPie
-W75hbvH/Jcf37PIXKl0eJYF9qezy2EVLd7aqswvdjFqvFaYpVilaLlH4/4lt+UqsdB6pJZpOSiktOxLU
-4s/+ySKq3pD+NwAA//+V9p1ERDcAAA==
+H4sIAAAAAAAC/+xb34/bNvJ/379ivvoWkI1bW7nrtQ/+dW2aNMihzQXdDYqi7QMtjSV2KVIgKW+2C//v
+B5KSTMmyrd1sGxSXPqQyNZxf/MxwhtTe38NnZUkTmC1heo15wYjGF0ST6bt3r1/AbnexSOgWYkaUWgYx
+co0yWF0A+MMaST7JCScp5sj1ZF1qLbiydACL7PPVNZIcvm8oFlH2efXS0QJNlgEmVE8sLzcYgOAxo/HN
+MviNbImKJS30LBVaGHbXZD0az4Nah6KUWM2baJGmDCcbKpUG74X/PCkkzYm8C5xuL1ATytQicm9Xi7Ws
+OQvO7iYqJ4wFEB3ROiU5DtL6FcnxvOY5TRKGvrrBysz8UDXdQmG+RqkGqfu9pT2vMCNtT1deddOHqbuI
+ErptkNVWWZN1I53hRtfI2giZt7SyA83ThDCackwCILGmgi+DiCQ55ZFhqqIG+7tdpMgWA8hRZyJZBm//
+c3VdyfDw2zi/Bq/RgCJLFOp6oB0YRldY03SiChJjsCdqk1mFY8G1FGySSlEWLVKABSNrZE0UVpR2MICN
+kC4EOcmxcvwbkuMisgQdTpQXpbbubaaA+df/vSWsxGVwf99JCYYr7HYBFIzEmAmWoFwGjcC2ffVy9v88
+aT2cTigVCwKZxE17Tfsw2o56RigPVt8QHiNbRKTFsaLXdwUuA1Wuc6rPM6zTyLsiIRrBeKPB+1HmdeyZ
+dVhr/gIZajRTz8pDKYUMVm7GEWktZy+iNkYXkQkNL+IOQs4ms27I7WEMGU0S5B8ag0bK8BjsiTNS4Ta1
+mI28N3XAvrJRsI/WB0Xd+Zgzgl3MGUHHYs6LuGaCp/npiDOMj4RdIzN4ZJQ9wlpG+U2w+o7ym/OGWlrP
+UPf7pKGG8RFDv7OS/zRDN5LkeCvkTbD6tn6MXvKU8gFLvJ/sme8NnvRBI+6II7rq/IlOSVDFJvO4GoEK
+3ucKje81kUgab9hZniPc75ZhHsvpdBqs+j3jUcFut4hqSY90AEhUqCdFydj5fcXPU16KZmxic6PLi3/A
+nsPYRNI0Oyuhswm5xPfIbWEwck6hZnUVS0SuMqFVByaHDcWEMAaZkPR3wTVhExVLwRjorMzXnFBm5RPK
+Ubods+9Fzf3+HugGuNB9EPKUMq2Nt+RK3zFcBjmRKTVVbTH757Pi/TzoUfTcIlTw8appVyS/K5ggydXV
+t0LmppxeuQHYK+Vh4/4ekCn0tby/B0l4ivAZvYTPtocd20kTaZ5CQjSxBbVxYgvR9o1S9fi27v4a+xuX
+B0CYrqnaIRmAkvEyiBra1u7e4atkrLBhdF1PubLDjoL+jmoZ/OPZs+J9a383vuHJ3rru7xbOHR6GOsrH
+pg28oFVwWPAdLOZzzQeXiU8Cjq6BnvXNq2PFXasFPCjy2qVd07jXbVzTtWuyZtiODShIklCeGh77XWmh
+MySJ50Qt29tFtnJ1k846461GkRGZYrC6YiS+gdcvBpJf31KtUQ6kfpkTynpoV2/LNaNx35sfMBfbjvKL
+aG/iImqZv9Brkdythkd05fhWIHcdmKxcCFVV4iLSSYegz1g3x/rzdfLQaZVfHzrNOrh/UqdJtS3E2Z7d
+obiwq9PpG0BTbfL5jxnqDCXoDO9CicCo0piA4GYE3FQgMs7oFjs9d1PWua25ioyqkHGiaeIXc152iw5Y
+DWwtravoxvByqIPd7idU+93gjWjiva/LbO/j9cjTuDuxLaez/Eib9hE919ckL2hN+HueUDD/TJwVwWoR
+0dXjPOgH+OHm0xehEAumCsKXwZfB6uskAQIcb8G54hTz06waL7jEG/StarcxH3gUNmyVH3KE1emTON46
+GX4/3BmsANJpFlxOdJ0vkFKLjYhLBdEwccpkPIO+jsRmvF/oVy5TvgiGytEuRR7IacaPyLmu3w+Ug7mt
+yTpSqtF+GaVC+ZWlmMYi75fkKnvb+TVM60TbWvEM45u1eN+z2P361kw6CtfDLsobrrUFWpb2iAe+o0qb
+1J33p/BDQ/rOQB/cd00UTVCd776+TpL+lHLQmj40zywir3RYRLb88qq8+n91pVfa4lE1taOL/KrHSagq
+GLmbccFx7u5yss/76k1X7T3lAV/D3CnY3bSRx2498pJpWhCprYsmpjsJBrWmnT5uLbQW+ewL28qtLlqw
+rJhsKMMad+65gaZSboDEMRZ6GdCcpBgVPL10T78VWD+mdFM93eLa65CL1ds3ry7h329fvrqEV6+/BSHh
+R1y/nULT8SggEiEnCQJR8PbNK0PjJuAWOWyqKVAt6nQRFauLDqYW5LCxMHX49yIhrH130zqzOET2wTnF
+nxEpdSh0cWw6luEobs1S/qHB4wHTCTDnWvNCcRLfTG09yVGOLgAA7rlIcJaIuMyR62mK+iWzFxjP714n
+o7B10B+OL83y6llob9/C3aVlsSm5jaLRGO7tAIDKxK1bx3oEXHk7g9C7Bggvm7eqXNcEo2OXOGOP3qSW
+GYRfS4Q7UYIqJf4LrjOqICacCw1rhJInguPUn+UuZWbw816vWrPQgcijBiiEosa0WWjw13plXTCDBrHN
+q91lD29n9BHeFowd5kSpGYQHBWKLyoTPDMIz1W+41+zX6nE3ntun3QXAeH4MGRXxaYD0nGuF41rJFlrq
+QaNVSjTO9sAxIvbgAZCoS8mtZE+gen53TVKDhVFI8zSsjHBmGL+fQuPLhGq3UVxlQjtsOqtvJSlGOqNq
+/POzX9uOudizO8qE5mkt6RD1NaTNRG+fanxhUPzG+Bdonk5jJjianyNTPDRe3IN2X8F3oim8PAWZPdSg
+F2sWyD1+c//dUp6I2ykTMTHvYQlDdkuHvSj4mzHMbIYK9dSc1s095jsvaHpsqwOyz7Zeqw6CspHw68Ue
+97vWuvadZVUeoJuRLebUN5k58khG42EZ7h03/UgCbprqz3IHuSz4SZThttpWlcgRYjf/0iAAMrJFHmpw
+rG09eYf6EujGJkATfZSXaN7cwS1lDNYITCg9DYblv9rfPWoPTUjnoCRxi1JX/Efjeetl76Fii8KvDo6g
+qM+sVwKeEy8BHbOn2ukHZvmDXOqOO2oFtkRW1Y+xBJZwNIP2Fb7huJsM5kNw162HP3B/dZlpb0XHYvBe
+TW1NMq0qnGWwZiK+CWq6o7bnxpKJkRWOKxYZmlhehn//8ovifbhPx+2o7cRl5fYogm9MH2aCovnEowqj
+pA7p41tZ9TFJOJ5a9vB/SwiP+Socw329T5kFmsPuQAX/86fBWtQX7Ke18C/Zj6lyToq53R4gpbrhPiFF
+xbJcX2lJeXpaorlHrSWOjUh/ZjjkCjUcD/D7/qAKksr7JnvCGpGDPfgfsA6tg52Wmx7j7+6hzdMxrE5n
+no6hPWYZxK4a2hCm8HBj9deW8qLZVHkBS/PvVKI94xlFv8hfeJReQhCM50dp2hSVaMqLA7mdTWafGn5A
+hXqfFy5OZqaDZHAqF8zbIvy4Py3lINjPxvr8PMNWXJ8N6wEM/bA9zrATqB2feDHpLvFPi+2PPoPF+cCJ
+3UB7yNxuTD1kbjt86pltiLa+B/5UbH6EYrP9RfZftso8Csfud+m2oCRKmcPoKUmSUa9O4/kgvt6X4y2+
+0l4tfxDrnq+9/xARmqx7+bqLvwc5osvKevdhfLofOJzjtzuaUZpv9T9llY+YVfZ/MfE/llmeIDyPJZcP
+TVofK7M8Ih30ppXHZainyyzen9V8yi0fMbf4f970Kbv8hUqXJ0lgf1R2eeqipT9bNdmFbkada4VpRlQG
+yyWE/5/alq/CSu+WWqHpJJdKswNGnfjZXSyi+ob0vwMA816bNlk6AAA=
 `,
 	},
 
@@ -8134,44 +8191,82 @@ k7jqb1KxKCmivvvw1bpPPQ/6TBrYLxN3CTyIbyGUfWF1vm+p1iCkKEer56/sZwAAAP//WJzUo1ACAAA=
 
 	"/templates/admin-jam.html": {
 		local:   "templates/admin-jam.html",
-		size:    537,
-		modtime: 1570465672,
+		size:    3934,
+		modtime: 1792301411,
+		compressed: `
+H4sIAAAAAAAC/7xXS2/jNhC+51cMiBTYBWIr3WMhC0iybdEUzRaItz0atDixmKVIlRrZ6xr+7wUfsiU/
+g202F1sccl4fZz6SqZBzyBWv6xHLURNall0ApE/Glq28aiwOvGDzNeBKzjQKBjwnafSIJVyUUifPvExq
+PkcGJVJhxIj9+elx7G06qxKVqJHCEKDr3dvOjSZr1GBmTVOxdhlAqvgU1SbQuMoLGTwZO2LPvJxoXiLL
+7nkJD7zENPHzHSNSVw2BFJ3V4H67Y1pWOGKEX4lBpXiOhVEC7Yj9ykuEe14ymHPV4IitVjAcY1kpTviR
+Ex/e83LoHMN6vQk9TYScZxevny9xpZaTADLLxm4Ef/jRft41KsxD4j21mHzf1EYNYLWCy7yxFn4aHUjV
++wwuYb3uq1muZwiX8gou5/vaHc26r5qaypVTB+LL+fB3XDpInV35BPhPKwuxrdchPRSrFaB2sWRBL+5F
+mgSjO5mFpVuQkmDmyMaF0gnFUUghULfozQ1JPZsspBZmMamRNgVCtkEGyXco9ujTVKhrlv3lR/DJjU4V
+fU+rH32UhfwEJyRZ4kCZnKvj9R78erdvUvIx1lyZGrdp3/nhC/KOev3EW+G3ZB48n0j9eNGQxMnUIv9S
+n6oYV8Y0rQ80kMRbp4220z/n2y5qdXRek5DalCYubOlh2XashGtYr717GEus4XbpelDVrkPHBeoo8E15
+msH2/eyCyrLjlMKydw9G4/sjrBARJAcheQz9DpwjKTrEUlF4OR8+RoY6Slb0Omy1u/x8IT43Yub64G14
+y3nDSW4loZWcZffBO9xFyckju6/bib8rPXp8/9LoK7ixdAU3jZDmCsYFlrjJ+WzzuBPPhYttrLBeh632
+NXi1Rd0fPuE/CL4/MwYUFihnBQVUEf72I3j3w/vzqEbNHqatLCCqm3KKlkEp9YhdMyj51xH78fr69G3I
+RxID+UYcwGKNNKgapbqQTBsio2NwdTMtJbGtEaUG1jutK55juLZGjc73oLKy5HbJskc+xzQJ0r2WShN3
+480uoqB3YzaaUFO4MhcfspsFtwLuOOHMWOlOpeKDn6uyn3leQB5mljBDqkFSDWahXeF9QQFTrpQhcFkV
+COF8gorPcJgmlbMSym0f5a2/QBEp8anCHqRBsv0cTI0VaFFA59LvNAvkYoMA2Q7iVGTR0TJNqOjPdCVp
+0uqlScdeSlMjltlLD6tDiR0ISuze9Uj0p1MOhcWn3gNFoELCdjOSYOHz598+egLv4naoaBSXmmWpbBf+
+WwoJ7mcQ7LIsTWSWJjzrRrNFZZfP02SDTJr43cku+mv+12uMC9Fm+uaPstZxfJk94AK2NXSclPpqkZV2
+hEeJ/hZrckT/crY4Qw43QhzlBvfZxW+HK/4bAIiiO49eDwAA
+`,
+	},
+
+	"/templates/admin-judges.html": {
+		local:   "templates/admin-judges.html",
+		size:    1870,
+		modtime: 1792300706,
 		compressed: `
-H4sIAAAAAAAC/4xRTYvjMAy991cI3dP8gTinhYUedhfa+6LGausif2DLYUrpfx+Spp0wp7mYp+enpyfU
-WTfCIFSKwYGDcsZ+A9CdYvYvPtXMzUy8UUPizoEtAg3qYjDYkvUutFfybaGRETzrJVqD//7uD7Pn5OpY
-bGF9lgDr6bP3EIPmKM05x5rwJQPohI4s76CLaiYRTjEbvJL/H8gz9jvy8Ic8d+38vzJxIVUFZ1dqmN51
-rbfEBpU/FCEJDXyJYjkb/E2eYUceYSSpbPB+h+2BfRJS/kVK22koPB7v2F1r3dhvfrQrZC6sTaoi67WP
-VTWGJVSpR+8Uv0xEmuzOF4WSaODndZaOFW5Sdp7yDfs9jdy1T/ZbyAlMh+03C/EZAAD//z9tbloZAgAA
+H4sIAAAAAAAC/6xUS2/bOBC++1cMCB9j85BbQBEIdhcL5LAbNLkXY3EsMaBIgaScpoH+eyHSetixUxSt
+DyJnOI/vm4eF0gcoDYZQsJJsJM/kCkC0wxfgoVMVBTCuAm0BIwiE2tO+YPxleGIyn4KjhOgglM4TEJY1
+VNjQNgV5fwe9h+0zNa3BSH9jxO1fXkfyGqHvk80o340eHm1FsNY3sD7AXXHdPUdfa+j7m8GRrMra9SGf
+WTHGJRNoFP9zMKDXtoJyDFjjgWBHZCFQhK69AVQKYk0NODucixKgarTlL9gw+YBNqkGL1cx6yix4K1eC
+K32Qq0vVOJa571ci4s4QaFWwVNiwSQo29ig4ny3aztPm/LrZOa/Ik4JlL2NNqIbb8BPRj9cs1vKfBrUR
+PNbnD/9iQwGehp6qS+9LneA5sOBTOhF3Tr2NBkNHqhTwQzdznr6fLT/v/lyuK5yUTP3fJmbQ94JHddki
+k4O+B75AeNljIcLHKUiY+GlershQnLuXOrXrYnQWFvdNa1BbJoUeDb83SsPw2RwjSMG1HCZsiWmBcSz/
+x8k7NkHwNCFyNb9e2/36Vt4rlXdf8Po2KffONyc0kmK6bdDoypJigGXUzp7XxdIrg4Zi7VTBHv9/emYZ
+rdhrMipQnIgsQKXopbPRO7OpvOtaNvMXBndkYO98wWioN8uDDPdKeQpB8GSwcNC27WJarWwPFhuahPjW
+UsEifYsMWoMl1c4o8gU7icrggKajgrG58mmtfwd+iyG8Oq+YfDzePgM/WR/xz3KmMMsnNB6nJH8e+Fff
+Ria/UEsY4Vc4JMczHln3KZezTNcoHTcthwrdrtHx56vodYP+jS1XID8dB5YvJ1bwYfin//YfAwBDIF02
+TgcAAA==
 `,
 	},
 
 	"/templates/admin-login.html": {
 		local:   "templates/admin-login.html",
-		size:    806,
-		modtime: 1570465672,
+		size:    930,
+		modtime: 1792304029,
 		compressed: `
-H4sIAAAAAAAC/6SSS27rMAxF51kFwbmhDdgG3uANiwZNNyBLTCJUEgV90mT3RWz5N2iBoiPz0pfkocBW
-mxsoK1PqUJHPFLE/ALRnjm7OhxKpGRNL1EhrLp40glTZsO9QSO2MF5otX4xHcJSvrDs8vp7ex5bPpoas
-TpQnCbAdPrZW7HNk21wil4CzDaC1ciALZ44dkpPGYv//+YF/WkdKqRWjYVNgfCgZjJ794KWjReRHoA4z
-3TNCsFLRla2m2OGuK4Ismc+sSlqIhTa3/vAX/iBT+uSosT/W6Cf6xV0XWPW0w6p3exyXIb8CT98wR3Lk
-Boq4L7qS+hj4vinasa9VE/uqJ/a1Ht7qL3ihFWD/LHWDKoaSM/vaKJXBmbyHq4ZN3IRonIwP7E+jvxVT
-vh6n2F5nK55X3h/q0K8AAAD//5F9arwmAwAA
+H4sIAAAAAAAC/6RTy47bMAy85ysI3rP6AclAgfZYNNjdH5AtJhGqFyQq3UXgfy9iy7F9aIGip2hIznAm
+MKWxNxicLkXhQIEpY3cAkOeY/VJPNdNxKjxfR+3sJZBB0APbGBQKbbwNwkQXLzYgeOJrNApPP97eJ8mH
+qCVnCvEMAe53sGd4eSefnGb6qlnDOLamtCFVBv5MpPBqjaGAELQnhZm45oBw066Swvt9r/HyOvVhHBHE
+ZhcFs5HfBJ9iDTFwju54ybEmXGgA0umeHJxjVkheW4fdt8cPfDEmUylSTAMbwmzcmmW+uW5gDsT0wQjJ
+6YGu0RnKCneqCLpyPMehlkVZCmNv3eF//Cddyq+YDXan9vqb++d0C7DiOcOKdzlOzyX/ZLz8wXMmT76n
+jHvSlYafffzYkHbeV9byySx49r7y4bW14DutBvZ/S0vQQF+ZY2hCpfbe8t5cG9i8jylbr/Mndm/TvBRz
+vR2G2F6GFI8L6w5t6e8BAGui3BOiAwAA
 `,
 	},
 
 	"/templates/admin-main.html": {
 		local:   "templates/admin-main.html",
-		size:    1534,
-		modtime: 1570465672,
+		size:    2465,
+		modtime: 1792301433,
 		compressed: `
-H4sIAAAAAAAC/6yTT4/aMBDF7/spphESlybZP7dViISo1FP/aEvb85AMibXOmNqTIITy3SsnAUGX7SKW
-C0OS5+fn+Y2TXDWQaXRuEgTpDYB/4StAUj6k3+uFVhl8MTklcfkwfFjUIobBcKZV9jwJ1opzs460yVCU
-4ai0tJyMY8wrxXFlcopvx8Ful1VtKewdQjFFoSlcKusEDj7AdgtqCfQHoj6BDwC30LaHq1dWVWg32y0Q
-59C2QfoblSgukrhXXBz37r9xNZ6T9u7NtL/Mv2GTeOh+8iEMX8CYam3WlEO/DuZkK8Wo3aVksJbynXSm
-tZRnsvnGegNeTywqQ6EcZloRi3sPrv0ZLka2P8LbwKa8GTLDDNlzoJP0wtD/vjoVA9hdXEuu1uLCzLCg
-YrL9PSzv01ltrd/sqVckcXk/GFvkgmCkPsKogccJRHOqVhqFPqFgv8WhTrxQOuWoieaElduJOtmoiZ6Q
-n6FtH7tHaaKvWBG0bbKwEKc7u74RN8f/98c+enk8uZ4V/KDMAzwxry/BBWfyb4yQG3d3iV4ZpMvNxTdq
-HKRdw65tXmDVJf/s67XNa0fWm//09cSMDuVvAAAA//+gRRxc/gUAAA==
+H4sIAAAAAAAC/6xV3W7bPAy9/56CnxEgGzDHTbrdFI6BLsX+gK5Dm63A7hibiYXKkifJKQLD7z7Icpzf
+NkGWKzkSeXhInaOECZtDzFHroedF/wHYDbsChOll9KOYcBbDrUwoDNLL5mBSGCMFSBFzFj8NvWcmEvnc
+4zJGw6TopYqmw26AScZEkMmEgouut6ySF4p8h+AbOZtx8qdMaQNrB1CWwKZAf6DnGFgCcAFVtZ6dK5ah
+WpQlkEigqrzoEZlhYhYGLuJkuv1X6WYsSTgd5ts/yPeXPAvdwat0OR4z3MFBsvekC270JluH1RtTlnM0
+dIMGe19QP9acoapcS7kLXoYLabZS3CDuchK691X/JiWhqtwmSLtrM19O+SRVhga8WyngGwoYQP/D1cV7
+D6oqnCgIoraNo3iMuNS0SySut19i0iS9QmWTRBjk7Qjb7TBo7Bf+7/s7brzmXD5TAg2fMamMCeT6VGti
+YdJ/tOd1YdIjzXkn+AJsPAnDYjSUwIgzEtuSOrGH/qkmaFs47NdrsWg4wwiFvQda597enu8vn9IlJeXM
+48dSGGSCVKNA2nkxIGVJQmJVFRI06HM2J982OvT67qFOB9GoUMqSaa2ZDuqjPAp1jmKVOfTm0pD2on3i
+JW19EtiMCOo4iO24plLBDo4hzPbhjO3+Gk4d12i8HsQaRjOMuo2yBIViRtBh76Azh6vhFnDT29I2q3hj
+E0yd0Zm39Z2E1iZfD85VBPeN4skvS5t0j+KpvtiNn1e2SMfMe98xI+dbNq1rMPqoCG1MSFkEbyYLKMut
+k7dhQNnqwWk1sWn0/aZvPzZtb4UODxRb9e8x+67qvSPNU192t/4fohdceDp4rYCuF42dEs4LPsOsZv7Z
+rucGLzQpC/7TrucG1wZn1PWiB7vCDdM5x8WeV6RZ/g4Apze5+aEJAAA=
 `,
 	},
 
@@ -8184,6 +8279,36 @@ H4sIAAAAAAAC/wEAAP//AAAAAAAAAAA=
 `,
 	},
 
+	"/templates/admin-pairclient.html": {
+		local:   "templates/admin-pairclient.html",
+		size:    1088,
+		modtime: 1792304041,
+		compressed: `
+H4sIAAAAAAAC/7RTwYrcMAy971cIXXrKBPZYkkDZUthD24HuDyi2ZiLq2MGWhw7L/ntxMplmaEuXlh4C
+ipCe3nuWGisnMI5SatGwV47Y3QE0hxDHNT/lyNWcuEYVOTl6tghkVIJvsSY7iq+NE/aa6okkIoysQ7At
+7j9/eZpxC7Kws4l1+QVohvvuYe6CR1+wqQA29XB/rdhwnBmY4DUGVx1jyBOuZQCNo55dtyeJ4o/wECy/
+beol+aNI/JR1xXMUjxzBSqLesa36rNVJkvSOEU7kMrf4/Ay7Jx4nR8rvSWlXgOHlBa9tLa4RQv3TKD1P
+3OIg1rJH8DRyiybY1wy4gjW1ldPfGrLau/8vbjxOf/biX+jDIcQWl8Uq5mH3IQp7687wiUZ+lSQEsTcY
+6zNsMsszKX/T32stA2e1kyPDQ3CWY4s3fBAoazgEk9Mv1X+krwwpRwYdJEFZBJAEOjAEX745XIi9SZBM
+ZPa7po8bN/usGvyFccr9KIo3jl4KNnE1RRkpniFNZLg6cVQx5LB7l3VgX36UYdmUpl56Lhdbb0+2qcuN
+dncXTd8HALHCg5tABAAA
+`,
+	},
+
+	"/templates/admin-stage.html": {
+		local:   "templates/admin-stage.html",
+		size:    674,
+		modtime: 1792301151,
+		compressed: `
+H4sIAAAAAAAC/2yRzWrDMBCE73mKxfiQQG2THIOiQ1soFNpDanrf1ltbRFKCpQiC8LsXyY7z45wsj2a+
+WXtZJRz8SjRmkxiLNWWVMAeJp4TPAFiz5N5DXpI6SLT0ihbzd1SfqAi6jhXNMtomELe3ZCICgJkDaqjQ
+YiaFo00yXE7A30GP2JDgEH0BX1TC9eOs+MuxbUlb+LKoK6FrA8wolJLPJz0WpTw96CmD/kG22VeXtgUr
+eg4rmtXjj2rJHKU1yXXHVMtqVGQCwXtoUdcEqXiC1MF6czfItg9D18UfdfHbELAxkbq8JFSj6XqsWNdP
+AP0Z9S7zPoS2qHfQdQm/eV2HktS6fNig9yD+ovCGis4qZGfbteo9kK4uIZeXgp5bwgBmpDjMf07g/d3N
+ghWk+BgelwkwarPb82AZHv8DADxtKf2iAgAA
+`,
+	},
+
 	"/templates/admin-teams.html": {
 		local:   "templates/admin-teams.html",
 		size:    1266,
@@ -8202,49 +8327,94 @@ BlgqbI1wvqD1KJf7o2CyoEt8Pk0li0pnvc9ffwcAAP//TCDc4PIEAAA=
 
 	"/templates/admin-users.html": {
 		local:   "templates/admin-users.html",
-		size:    1023,
-		modtime: 1571429152,
+		size:    3039,
+		modtime: 1792300940,
 		compressed: `
-H4sIAAAAAAAC/6RTUWvcMAx+z68QWeFysMR7Xn2GlvVhsLduP8Cx1MbMsTNbuXI78t9HnKZkx40xlocg
-yfr0SfpsifYIxumUDmUbmENfp0EbAkOeKZaqAJAaLB7Klv0d4rdEsVwRwxipbkfm4GFj12k0hlIqoYv0
-dCiFxt56MSaKSXh6KZW0a4mfPVqYf/XgxlQbG42jUklhFdwhwswnhVaFFGiPqpCsW0e5oVyvzv5bR51F
-JA8pxCUvd3Vp1m2ISJHwtym5I42zNX+S42oubqceem2dFNxdHmxjUizAOfZaTnIb8LQmnM8QtX8muLHv
-4eYIHw/QfKV+cJrpk2bdzAMnmCb4UyeozucZOU1SMF6ebdys3BUFVrggtPxXLQenrb+uWMZnqbJC/8CM
-5Ijpf7hfK1xj365l1eN19eQRpmnRZ1FFinwlVCGTiXZgVSSvzfcmksZT9TR6wzb4ag/nAuCoI+T0+4An
-OAAGM/bkufkxUjw9kiPDIVblu83VVJmo3N8WAPapeoM3prMOI/nGkX/mTn1YOACEgMcuvAB3tLDl6BvX
-M/GDo9m8P33Garfh2u2bvKgvNnETqQ9HqnbLk9jlBqZi2t8WUqyz/goAAP//4jEBuP8DAAA=
+H4sIAAAAAAAC/6xWb2/bthN+709x4C9oHKCW8gv2B0goAenaARmKrljaN3tT0OTZIkKRGkkpdQ1994Gk
+ZMuum7bb8sKgyDs+d8/dPSEVsgOumHMFWRrvTb1wDeMIHLVHS8oZAGUgRUGWXt8K8d6hJaNH01pcLFvv
+jYbJeuFaztE5ApXFVUFyJmqp89ahdbnGR1JSOV7xqRYSws+iUa1bcGm5QlLSXJZwKwQEPJqzckZzIbty
+Rj1bKowBxfsW8XsXUSWFQA3O2GQXozpeLpbGCrQoDrL0FTIRVuGPejsu02dVvqqZVDT31fHBdI/myZHm
+u+uoXxqxGQ22W7BMrxHO5HM46+C6gOwd1o1iHl8yz7KQsIO+hy9FIsrtNnj2Pc29OD6bfMbKnajA6J6j
+kP6rtWwUk/p0xaJ/LFWs0HcgC1To8d9gDzecQp/SMtZjoB61gL5P9UlVoXlsiXJGHbey8eXMacYfMotM
+bOarVnMvjZ5fwHYG0DEL0fyFERsoQBje1qh99leLdnOPCrk3dk7+N2nNMgKRi5sZgFzNd+4Zr6QSFnWm
+UK99VV4mDIA8h/vKPIKvMKHF3R3WGv0rhWH5YnMn5ucTrPOLLBL1WjqfWaxNh/PzNBLnMYB+1l/czGg+
+5jodf260R+3TMFRX5e3bO3hnHlA7mldXcbcp76OjA6YFSO1xbVngx4EL1DLwwQGkBqaBciOwvG19Zaz8
+FO2u4QUyixaeKX8TbZ+t/Q3NoyWEiUGb0bwJaNstyNXRcLzBxxjSUMRp9HGSIYrXokPrJWeKpNJTqZvW
+R83Q+LiIuAT8psGCePzoCYRqG6024OQnLMjPlwQ6plosyHb75RAIGM2V5A8F8ZV0mYsNML+4IZAnEYiS
+ddh5p9JKNA9J7QUuBnqscN+uaE9qWhCuN6zGQ0ELu/fcNOg+3//FIvMoPj94zZwPOn3i6JQ0Hojjd8vj
+lKoTSQ3imIXUPlPI4XgAcAHBRYizLktZQ9+n+pw5CX3/fF+5cK3r0iLtnLz7rMsGnrJfja2ZB/Ib0/Dh
+Cq4uL3+C//94ffkD+ZJzwO2yQGdgM7tzf6I10PdvsEMbcJXDIZSJ2ZM4T0R7WqJTzyWlzt6/v3sZ5Npi
+Zx7+M7mGP+J1QbW/RaonYh2XSa4PbejK2PogvLixWy2YkmuNggCLen46aR4rR6BGXxlRkLe/378bJ2kl
+UQmHfhftRHsiTJBPa9RibU3bkAnVii1RwcrYYaA/aFYjKZOIpBGMJhOXvV5NPCD8Hu5MJaxRjGNllEBb
+kHvP1ggvpWsU25A9w4Me/YMEdrJwFOtXB3Y3WE8w4oLNh/F1cNhnvEL+sDQfCTi/UVgQkbK6llpJjYul
+Mvzhhhw8AI75O75/ymQ8G6ncg+3lP/mUMC73eZzgYtK1x3wPA5OAXLusv+XxZWXN7IYM4pv+H9M8nY5i
+Om1Nmod2372W/x4Ae7bYl98LAAA=
 `,
 	},
 
 	"/templates/admin-viewarchived.html": {
 		local:   "templates/admin-viewarchived.html",
-		size:    1133,
-		modtime: 1570465672,
+		size:    3666,
+		modtime: 1792303148,
 		compressed: `
-H4sIAAAAAAAC/5RTXWvcMBB8v1+xmEBbqM/lHq86QWloKbR9CMkPWJ/2TiKy5Ep7Rw/h/17kj8RxQpv6
-aSXvzM6M1ymBOYDzDOtbalqLTNfIuL67+3YNXbcSypzlZ3+yyr1hOBingDVBoF8nikwKMOy1OdNaVLlz
-lRKQjTRBYW8xxl0RW9xTIVcAQm8g8sXSrmgwHI0ra8/sm+2H9vfHQqa0EPITm8wmKr3p4bFFtyBg347o
-tyk9t9F170SVYXI1inwiLVA8WY7l3jtG4ygUciX0Rn4xDi3cDG+H8SlBQHckuDLv4eoM291C7A26e+OO
-MdsHSAmuDHTdtq/O2UUdoBpCcqrPaBKkN/KTtXBL2IzDBGNtCYzaFZxvy/5cPCTqw9DQngKVy7KsfVAU
-SMGeHPeeAARrQpWr/AgOUzkctcxZi4r14n4a6Z29lBbDkQr5g5qaQnxl99cFsaiG4flulCS49uoih9j+
-nnIf0hDxi0aU7PN+WJ32iaTYoLXF2JKFPa5YK0XFakH2kp+UwJLLBGMOPfxV0FnH8O9NKr4bd/9oauRA
-0IEOuyKleVvXLXnGdZpjnxv8v9EV/mvG3PH0Reer3W/ifNv/BAAA//85dTHJbQQAAA==
+H4sIAAAAAAAC/5xX227bPBK+91MMiPyIDcRWNxd74cpa5NAWCdqmaNwu9nRBiWOLCUVqScqpV9C7L0hK
+tnwK0v8mETmnbz7ODOm6Br4AqSxM5liUglq8pZZOfvy4u4WmGcSMr5IbVQkmzy0suGRgcwSN/63QWGRA
+dZbzFU7iyGkO6hpQGOxMIRPUmBkxJc2QJAOAOL8EY9cCZ6SgesnlOFXWqmL6rvz1niR1vQfkKy2ctzjK
+L725Kancc2BV2VoP6/owjaYZxZEzc/an8r2lFid35p+olYuWaogSHyu5CgkyqOtjNh+VLqgFck9lRfUa
+Li/g8t27vxKP2TtwlEjmGQkcnSCGAmczklr5HY1VGu9pQTq1stI4TitrlSSgZCZ49jwjT3RFTaZ5aacm
+Vy83Si64LlrzL4pRMRy9J0nMOzf/KxgH92dcSaZIEkc8gVYfqIGbSmuUFu5pEUd0F9WHX6XS9iSoXONi
+RiLKCi6jtiiiA8rasorQOzsOjakXKRRlLbwQt4OzULrwiHRA7egnQDPLlXx7+NaYQIE2V2xGvj08zl08
+5z7pzmnnoDSaSlgzzpS0lEvUJBnE+WXykUsq4HuQwvAg5Ce0cyrE+ouP1FbzKJRzXcNZKWiGBqazPbvv
+VD5/C7Km8ZqWY6qRPp9QnnO8DuKgr6lcIpzxCzhbHbfgcumVQ1dIhr82cM44NM3U7Z+toGnqGl64zTul
+LRKvBzEWyTBd+w4J2WGxLfvQTIPNugcuc+iyI/BuqMWl0jxk73h2ULLV7jg4kqXTOZVbtuqz+nqK2WqP
+1D+b6faLL/aTVEXKJbJHSyXrALtcOwFsJcP7ii3RHA4hv/935MvcQtP8MTrJzBtiQ2DDZ74lZzJHWnTE
+BwbqGkrNpV0A+WPylwVxWhvQHS2vsLFpMN/O/XHiN/xQHK9QW55RcdDeK2XRtCPkZI9ve/vTh3kYsMZq
+JZfJbTtf4KfzM42jdt+roMDMgqQFzkgWinDtrQFiVToYsKKiwhkhycMKNRUijoIgaP12cR9x3dZ6l8lB
+7e/Fa0kFiKOA/zCVhb+ljieSmRVJbh5/7vrdU3oySpLk/vHh66tqqbAkuf48h+FDifJx/nP0qjpN+YIk
+V9d3H2E4cYtooql8NjtWO1mF6wbsusQZMVVacHv0PnrLzdIVQhwFIzf4wwXgu/BKCHClb0JLxZamAv3d
+Y93u2K83wY3SQcGj2P8cp0oz1MggQ2n95QEQ2xwpC8S4le4+wzJP3IHHkc339ruQSor1WFC9RJJ8wSJF
+bd6o/WnPcRyF4HG0gRTbVLF1MuhX9IlZ4knqSvlIIiwJc6Sr33IHkimoEKRVccC2dV4mcWTZnrNj+dQ1
+CJTOQcuDN3+TaU8jzOgOxWfuh2BP7l9D4Z1T130116O7fjYtubO7l+DvhQ4PoNdi9DPuTrQ/c30l9gfy
+IA6vx2SwqKSfsXDyHQn1ALw0rGsfx5XIFMiVRlirCkylEWxOrV+9UGnBKijos9vlxv9yyNon5tJx8ESL
+v/1bznvbT7SAFy4EpNj9tGCw4NrYC6ikQGOA23MDWJR2PSEXAYZvXzP9V71hw3IrcArkH2jIxWbXv5un
+YDVfLlG3CfbF1Jgp9AfJGLVWuueiVIY7pqZAtLt0N6LmAg7Df1WH0XPOAqlHnQpcdD6b/7j/zej9oNke
+0C729lw2Loej06cUTLhcbrifwDeB1CC8UG4npA0GwFRWFSjtZIn2g0D3eb2+Y8Pz3qP7fDQJ43fo8cVR
+V0r/HwDkjL+tUg4AAA==
 `,
 	},
 
 	"/templates/admin-votes.html": {
 		local:   "templates/admin-votes.html",
-		size:    1120,
-		modtime: 1570465672,
+		size:    6095,
+		modtime: 1792304768,
 		compressed: `
-H4sIAAAAAAAC/3xTwY7jKhC85ytaVo4vQRrpXUYEaZVc5rKH2Wjv2PTEaDBY0LFkjfzvK8AOiSe7t6ap
-dhVVba70AI2RIRwqj+FqKOwaZ0lqi74SG96+iOPVe7QE7/mes/ZFbL6+wEt7Qdjq/2A7wOsB9mfseiMJ
-T5LkfkbDNG0ACpoinBJ+O+zPKLsZkkDbYf8u7SdM02s60rD/KTuEaeK1Bybyp9CqOFOqqPK3IwxQjxAL
-D+exx7XSz+dK08AvknQNWPRui4qh8N9xMqUHseEka4Og1aEaooJdOleLp8H5DOivHnfrclc7r9CjggYt
-JcMBOLUolUiWcPK5SG1x1h1yRu19L/ql7SWs+9mG/Kz13UmHxg3ox9XFotpZM+6M9BesxNHoGP7bqWA5
-y7JiZ5bKqXZqFEuO/16NH8bktObgH16pRN6D+NZAsuuj+YzUPWIuAbgz5VB4m0jcLEt2bJ1uCts8aXQi
-asqGMaMfPjYHfZtghWwtKEu+W6QnojPmZv13xDP381DO4E09zCwpPGrl7JYEpw/n6Mki3ZgMflAFjTOh
-l/ZQ/Z/4DNq/5wVnR9Kkfyx8l8LZTMlZ2m+x+RMAAP//G9PnwGAEAAA=
+H4sIAAAAAAAC/+xYW2/bOhJ+z68YCCk2AWoLG2BfCllAbt1NsdsUidt9pqWRxYYidUhaqY+h/37Aiy6W
+5MRF24cC56VVyJnh3L65OEppBQkjSi0CiWrDtJolgmtCOcogPonyi/h6IyVyDQ/uHs52O5gvsSgZ0XhD
+NJkvCWPb/6HORQp1fR6F+UV8stuBJHyNcErfwmkF7xYDrkZeXZ8AdNTakGtLf1rNl0gKT2KJTqv5A+FP
+UNfv7J+6mn8kBUJd73ZAM8tC8UoiMTQQYRGfrbaes3dzHoVYxLsdIDc6RysJYez0cCcn3VdUmpslRQVE
+IqykeEIOq+27Pb0PWNm8qToVKdT1W9A58u45q6D7H5ky9px9FDqnfH3eUyQsj/CrVdRQZ0IWTXDLjcSZ
+PVAlSXBWodQ0ISwAkmgq+CIISVpQHlZCowoTQXnGaBlAYcO6CD7dPy6D+AQgUloKvo6XFFPIhBxEJQr9
+PUhMhEyBgBEGRtpbeKaco4SMSqX7Pj/VRtoo5EdkRaSQYaKBkwIXQat33CSM5xeGXzh++5ZlBohEacyH
+irANLgKjiqjmnz/f3UBdB+Aihn8YAYYR6tq9h2kbltgz+TyMQiey1cAHDyAKHe9+ngFEq43WgoPelrgI
+1GZVUB3sBc4T9L5npaQFkdsgfnBuvjZOfs9oGYWOIj6JQhPw+GQvp70/EuOPZCJ7ronGtZBNDuUX1ryk
+ai62jZ1w5i+uCGPC4hj85+EKYOh/G9wf0P9X44vycqN9MuQ0TZEHTXb7EAT9dO2Hps3b8G+k/vZI7b5o
+NgSpKFaUY9pAtP37UROeUr5WcPZhk65RwahZ2/P/I13nGur6jcdqpMmKIdDURMYJm9mjffMcVfc5WwmZ
+osR0mPY2/3SOJI1d9LR0H/Y4NjkYhTrvn5lUGZ592qwYTWCK3NoBlxVKssbhZeOQ7jwKnQZR2GoV6ZVI
+t8P8O9BV+y4fGZTGe9CKQp2OL419XepNUThrDwtxdc4a7u129a+UlOsMgjfziyyYJvFDxayrfGPxnZh/
+OjE9mzvyxpFDxHhnRqFNjNhMTQ78OicauICvNmA5UaASYVKGSDROf8IUDKZypBJKF/CSkYTyNRAmOM6b
+uQd5m/F3XGlihtINF1nWzKbHTp6W6Sf3oddaCc2mtRAbnrp+0kFQ2qtfCkDz7BA1vn0Pj2+/5WSjNI7o
+bxktKCf6Z+Js6JXDWDMkE4nsP18IKWHMt3CAHvGpduFuAtoG+Itp2f0Ityxt+nvLJzDdOu84VdGoio2q
+nYPH2uI4817T61jovpy3nwiVz1Rhhw0DyP8gSWdazMz/vqWU8S1JckiQMaCmDiDwTbFCCSIDOwe52lBK
+zFCaHDYkUjz/Q4FGUoCoUNqzRLBNwf2xX5164iak7HG4AtLDV+lN+D6EDbheQVgfLK9m/dinnSCXR13j
+6EvtBfpHgdeq8CCep5GXTzeyCSt724VpI8hYzybfyJJq/ogs69uatoFoPK2QZUHcx03Xy15g83Oxq/B2
+PNRUMz817hsAlQJPfl+WgiPXzVXQrD7vhTSUs4bwck0oV3qA6CHupsNzPPYMqlzpWW3BfEhYbksc9rin
+6XBahkdN9EZh1+NOu85VdZWj92aY0ir+odUGv5VC6jBxvxp1C86/b/d/P7gRz5wJklrTVLeNjDeFZuuJ
+p/aAIL43cw5jo2n++zbdAyvG1HZ1aCM+bp/YN874lehp0xJVBfH145d9uQOir0rwIP7weP/xRbIV00F8
+9d8lnN2XyB+XX85fJCcrmgXx5dXdezibmz/CuZnU1B7XnlVHbkZBHNHm+M8ipWD+maU+FwzUaQxNaowX
+pF4Nt9k2KOBKyGH5nqzkCXJtf998sYIvaTFaLMzwZ/ar4bnDp8Pb8O6GqsS0su3gotFacLadMSLXGMTX
+jCLXcHfz8yaqS8aaCebwNGVsVZoU5UtjSiTY1MwyqPa5oMlwvIoYbVDTgoXRwzOLsbZ7bHqy6lW4gxtV
+6/oxxZT3HZOLwd13rT1GZCaEnkik9iWGmcGEYKokfBH8y77HkB+OFyyFJsxVyLEqUeifbJvHXwMAtHqB
+A88XAAA=
 `,
 	},
 
@@ -8301,66 +8471,166 @@ y8XvAAAA//8ib8OFFgMAAA==
 `,
 	},
 
+	"/templates/judge-login.html": {
+		local:   "templates/judge-login.html",
+		size:    613,
+		modtime: 1792300693,
+		compressed: `
+H4sIAAAAAAAC/6RSPW7zMAzdcwqCu6EL2Aa+4ZsbIL2AYjKOCkkUJCptbl/EURwHKLp0Mh/x/H5A9eQu
+MHlbyoATR+WM4w6gP0kOj32qmbtlsU6d9W6OTAh2UidxQPNRaWZD4mV2ESGwnoUG3L8d3hfJm6hjT4X1
+DgG25ov0JFGz+G7OUhM+aAC9t0f2cJI8IAfrPI7/bx/4R5S5lN4shM0PLqaq4OjBh2gDr0CviQdU/lKE
+5O3EZ/HEecAXVQRbVU4y1bImNuQu4+4v+ZMt5VMy4bhv02/pV3Yr8MT3Dk/80mO/mvwc/FhVJTaNUo/B
+Kb40aYTN3KXsgs1XHA8Lvzf3fbut2R63N7dHMu6a6/cAgn/RLGUCAAA=
+`,
+	},
+
+	"/templates/judge-main.html": {
+		local:   "templates/judge-main.html",
+		size:    1248,
+		modtime: 1792300693,
+		compressed: `
+H4sIAAAAAAAC/6xTwW7bMAy96ysII8c6uheKLy0wbBh6WfsBtMTaymzJkGQDXeB/HyTbsZMm2w67JLT4
+SD69JwqlB5ANen/IShuCbXPfoSSQZAK5rGAA33pVaVMBejidYP9KbddgoGcMuI85gnFkAAKXRl3vKC/7
+EKyBTZx3DWqTQe3o/ZDxYyzlyja2sn3ICqGX+l+t0hB/cjqSjCmuC/iecIJjwQRXeijY6QT6HYwNV6Se
+nA7kNEZe2wsud3qxcJzvJBdojQNBSWTAU4C+g3frINTawxHbdR41nm4P/YIt+T9MrFJ+HSMdYSB11TrW
+d9fVDOCHtI6AUNapEVjzyCDa4dBUBDv9ALsBHg/3lZjE2mkYx4dYSEZNp7th+p8OmOBdwUTAsiHQ6pAl
+m/L0nV0YPEHWMC+tU+RIbd+OCDWhilGM3RSk4yIKBi/YkuCh3iZeCdubifVb8KmX4Of+IpRWfUzpv+py
+NusTL1UkSfaRRILtIxMYR8GDugO7g5hDgFn6YZ9cVMvchMPLddh0fXv7+gzjmP3bVt1cH1mT/Dmvz1Od
+FEkUfNqiDb3l7f1PWk636D5uE/MB3cwrEfrEx2xUWnVdbL/ECD5bL3h6iAU7Z9nvAQC2HMWi4AQAAA==
+`,
+	},
+
+	"/templates/judge-score.html": {
+		local:   "templates/judge-score.html",
+		size:    1070,
+		modtime: 1792300693,
+		compressed: `
+H4sIAAAAAAAC/4RTy47bMAy871cQQo61ddhbIfuyCxQL9AUk+wG0xSRqJdmVJSGB4X8v/Iy3dZCbTM6Q
+Q3IspIpQamyajJVkPTmWPwGI83PetpAeyNQaPb2ix/RAaNIvaCj9joag66C4wjZqAgh+fu7LtS2o491q
+X5X9DV3Xt61zgXB2dMxY2z4isPwxRnDMBa8nEWTl1OhYOTPPXQdHyRBYXglqdbIkGWDpVWUzxn8FeSK+
+3fH9/e0Vuo43GImBIX+uZMZ+/tgfhm32/RRp2ZAfPwcxO6MsfM7+qfdN2VHigsLLBgovH1AO7Ylgpz7B
+Lv6PfnHKk1N4o6zPPgxdVta7SicnV4WazSoBhMaC9OKQCTUEGRwrl7GmrBwlvdKYvllJl/kyu3izwUBY
+VVW2Dh6UvEMHi4bu5fy1pozZYApyDIyyGZu32acNXqYAjnhHf4JyJCcX7mK67+tC10XUgUbwLcgWn8x6
+BZcqrg63uOjxJsFRQz6pg9brpc4eH03FPvCL4H1lWf6CtiTd+/fGG3PTBppQGOW3yLB6J7VTBt2V5XuM
+BMOUjeBjcmNCwddWFbz/G/KnCfB3AO9pcQEuBAAA
+`,
+	},
+
+	"/templates/public-jam.html": {
+		local:   "templates/public-jam.html",
+		size:    2263,
+		modtime: 1792302693,
+		compressed: `
+H4sIAAAAAAAC/+yVy27bOhCG936KgeDFOUBiOS7aRUELaGukSBZB0SRddDcWJxZTiRLIsVJD4LsXpCRb
+vgS9roruyLlwhv98opoGxo9YwOs5TO6oqHJkWiDj5BoLcG4kpKohzdHaeZSWmkkzpKSZTJSMAER2kXRH
+TG6wIHBOxNmFdzUNqAfQJbfeBTJNruxnMqUPyl5s84LnsjQFMkTXqNdoNjA7g9l0+ipqDwzBpKXvCEBU
+fpuTbvPvCAsLzgGHRYWGVaoqZJKAWsIw9lPJFGLrsHgiQ5CiZRFXu573hbhl1FLple2KZ7PkUmnM4SPZ
+dc5WxNksSMG4zKnXqlobOm8tu+X5sjSSDMmhhD4zI5Tt2u9Mvwyu5EOOKYmYs33zey+31/zY5RU5com4
+P1jEg4KCl6Xc9EFNAwb1imCszmBcH2NxoMaJhmUYbD0JbYNzTQNPijNvulP01hB+AedAUJH8t9z4ihNw
+7n8RU7GbsohZ7h8qEDJDD/MofsTCxj089/dXC3AuXmFBrbUOQPSOKBnYvGQ7TDE5rjII3saxPJYQYNtq
+L2kvo4jDrDucKLfUgSNVndyUh+SB0sCZsvCIhYh9zGj/8N1IUj+T9MRQ3iHTqjSKBoz6m6T14KP8qyH1
+V/2H5k+i2cZ0vLzJc/BNbN+zg/e7f2UHJIeLtSQHUkg+C/PuK/gNAL/HwEGbJwHYbvoLjutW+9vUEGmb
+lTxI78LG1voCSkv6ejJhup8iVLGCjNQq43n0chqBNelzdHC2LpYaVd4R0tvbsltWAHOeR51xQTY1qmJV
+6uCLD641mH5HwK8TewDrj3L6RxENv/z9nqMAbIWWPWu2baVKRh113wYAnzG0WdcIAAA=
+`,
+	},
+
+	"/templates/public-jamgame.html": {
+		local:   "templates/public-jamgame.html",
+		size:    1276,
+		modtime: 1792302693,
+		compressed: `
+H4sIAAAAAAAC/4RTTW/bMAy991cQRo5N1A9gh8HxLkGHDeswYOkPoG0mUifJhqwm6AT/90GS5Xw0zm42
+H/X4SD46B7NXVPB5CYs1qVaipRVaXHxHBX1/43F7AV5TxPNa7KCS2HXLrGq0JW2hIm3JZMUNQM7vi0ix
++IqKFj9REfR9zvh9hB+L8h2GjAG8BbSQI3BDm2XGXlF1bJC5eHn5toK+z4oUGAmxyBl/9KTOgdicyf0l
+sQp5bfEktOg41SA0tCHs3FQ2a30h0rVvNTGPzfwQ+k/kHNU69wHOigvBqPcq+5NBRfvGDCWesSbYC8vB
+uYmk63wr6iojWisaHRjT1iRt7KnGs8wT2o+8vytDpDve2C7WPbFEsMIcpQTeGPG30RblvKtMIyVY/qZK
+jULOvXNQ6ME0oYRBvSWYiVuY7bz9puvBf8zChMItsaHDFPS/u4OfAg9ALtQ2aR/lZYDSxt3uzoaTQWeq
+qbojwdXaX/bLT3d3GbCowTsjzWBcZc5qsStujoMTRn8mVZJJm+APRTjUIZoz/hDOzmIpKfXZvhmax8jh
+c142piZD9fE1+5dlU7+naV1Y0xU18b1Jj/1PXcRRjFds60vwei+sJXOWkbMD2fGwApJk5iy0czq7YZz/
+BgBHlZEX/AQAAA==
+`,
+	},
+
 	"/templates/public-pastjams.html": {
 		local:   "templates/public-pastjams.html",
-		size:    972,
-		modtime: 1601649803,
+		size:    1060,
+		modtime: 1792302693,
 		compressed: `
-H4sIAAAAAAAC/3RSy27bMBC85yu2ulOE3VshCwhSoKgPRQ9Gel5Ra2pT8QFybbcQ8u+FJcVW7eQkroYz
-wxmyavkIpsecN0WOPeZOmeAF2VMq6geA+w3jb4CqW90QO8J2BgEqdvYGZ4eWCsjJbAqNOZNkzc5qNmJf
-VB9sKKO3Bei6ahLoN6XnIOzt5Km71eweb8TzoZn8n3o2v6FC6BLtN0UnEvMXPZqgoxd0pQmuqDtKVGms
-YR8SuJAI2O9DcigcPGATDgLSEXx/2n1DR1t0lY5jIbrlY/0wf4YBeA/ljlzsUegrCpaPyXR8JHh9fVi2
-d+6VvKhTwhjfaXfGL/Wub4nnfMBZGfJy5v/ELLBFlyvdrSfWMEBCb+njE43aC9fETRM89KoJf1SfLMRD
-ImWv97jYO0IHtYJ54Vq1Uuu3qbfq84L4cYTloCb/oh4GKLfoyh/ozue8Rppu+7oGqHJEfyb05CfSjtDl
-kTVCIOMcMQkbjijU/vei3hd5DkJLkeM4nygRGMxyp/Do20nlUk9ih+nvlOUXe0/pGmfSPGGGIxsJicMh
-f7oG1JeE88O6WQ4DkG+nC7x7gTPyLwAA//9Od9MYzAMAAA==
+H4sIAAAAAAAC/3RSzW7bPBC85yn2050i7O9WyAKCBCjiQ9GDk55X1JpaV/wBSdstBL97oR/bsp2ctKvh
+7HCHU9R8ANVijKss+hZjI5SzCdlSyMongMcDw2+AolncERvCegIBCjb6DmeDmjKIQa0yiTFSipKNlqyS
+3onWaZd7qzOQZVEFkOdJHy6x1aOmbBaTur8bHvfVqP/SsvoNBUITaLvKmpR8/CYHETS0Q5MrZ7KyoUCF
+xBK2LoBxgYDt1gWDiZ0FrNw+QWoI3l4239HQGk0h/WCIrPlQPk2frgPeQr4h41tM9IoJ8+egGj4QnE5P
+c/d6X8kmcQzo/SfuTvjF3uU9sd8POApFNvX8nxgTrNHEQjbLkdV1ENBq+vpGALeqgavKWWhF5f6INmjw
++0BCX99xdnaA9mIBU2FqsRDLc9dq8f+M+PUK80aM+ll5eTC5QxNl10G+RpO/v7+9wumUlecfP9D0e/RP
+d117TMS1BiiiR9uTWrIjcUNo4sAcIEhD7zEkVuwxUX2Tus+HfLhE8yGHoT9SIFAY08OEKR6/2FoK57vP
+8GdbjyoXiwMbDH/HfW9pZ80jRjiwSi6w28f/btXI1nOFKbNjOQT2rpxTHpI9If8GAAY9WdwkBAAA
+`,
+	},
+
+	"/templates/public-results.html": {
+		local:   "templates/public-results.html",
+		size:    1232,
+		modtime: 1792301433,
+		compressed: `
+H4sIAAAAAAAC/+RUwYrbMBC971cMi4+7FsmxKL60UOghlBB6n0gTS1SWgjRxKcb/XmTHiZ2k/YDubaT3
+ZjRv3iCpbQvKYUqbVxU8k2dQ5Jnia/UCIM2q6joo99ScHDJ9QcbyGzZbbAj6XgqzGmnr6kdg62swmEC5
+kEi/gaFIgJGADcHRenSQGL22vk5SmHVO7TqwR/CB7x7ZUTo7TtD3ub62bbUNUGNDCX7lskOPpKXI0FiH
+XKILn/HgaNJ1Okd6H29u4fshRJ0rzOXmTEOoxzif4hQOUPXdoSIp2Cyvv+Zx5Jk8QnvC5gGSYiosxexB
+yYegf0+kroOIviYo7BsULXza/GtEywzOKTzkFG2Ze5jR7lTp7HDRljv0PwdPWT/C3JZZZHk1/i+kJ/hN
+7OiS14uOZ2cprgOQYnCpellybgpVVqieTOUzMtUhWpp2x6yH5tSsuXH1/tctyVI/3G5cPoI/AwBYWleh
+0AQAAA==
 `,
 	},
 
 	"/templates/public-teammgmt.html": {
 		local:   "templates/public-teammgmt.html",
-		size:    10632,
-		modtime: 1571429152,
+		size:    11414,
+		modtime: 1792304428,
 		compressed: `
-H4sIAAAAAAAC/+xaW2/bOBZ+z6/gcgvIxsbWLDrTB98w06YdFOh0F5MEi8VMH2jxWOKGIgWScpox/N8X
-JCWZkmVH6Q1Y7PghkXg51+9cKGm3Q8/KklE0W6LpDeQFJwauiCHT29u3V2i/v1hQtkUJJ1ovcQLCgMKr
-C4TCYQ4b4wYRWmTPV7tdm9J7ksN+v4iz5/UahWJHI6Zsu7qoqFWT69IYKRCjSwyUmUlKcpj4QYykSDhL
-7pb4P2RLdKJYYWapNPIGSP4zyeGGrEfjOa4FK0pV750YmaYcJhumtEHBRHg9KRTLiXrAK0sMXYEhjOtF
-7GdPCGiA5JMc8jUoPUjQX9zax0XlpC0pXtndyG9vSdU2ZMd2hqxx6Cm0ZulEFySB2mcbqfKWHG6guZoQ
-zlIBFCOSGCbFEsdW57jBzn4fa7IFyw2jHEwm6RL/8x/XNxUDy4IBpxpMPYDQgiBBclhivy0OZrLnXlPr
-hANs3FSAOidfIoVRkk9SJcsCHxYitOBkDbxBbrXODWK0kcoztiJU3n7vuLkFLTpMFKVxNm02BJL7+y3h
-JSzxbtcJIkvY4R/t9xgVnCSQSU5BLXHDM5C68uNX05YzcVdp+y9Ya2YGKOz2BAr7+7MKv2Pi7oTC75wE
-30xhCjrBqyvwEcik6NPXwEdDFJBGZbcrUNnft5QJSE6nU7zqN0SwCtkMWHP6dgbYKJLDvVR3ePWmvoxf
-i5SJAa4/bA6MEQyeBUHD7gQSuuJ8KiqQAg1mUpSct+1DUKZg05OsgpzL+cSlRJcOT1cFTpjAq1dEJMAX
-MWmxqdabhwKWWJfrnJk2A8XS7FEOdd25LSgxUGW+sOwc2WURt3PqIra5uroeBqNzEFpdJwpA6Ewa3UHK
-cUcwIZyjTCr2hxSG8IlOlOQcmazM14Iw7vgTJkBhB66+iZr6bofYBglp+kAVCGV7k8DX2jxwWOKcqJTZ
-ClrMvv+u+Hior4Ggjzmhwk1QuX1Bvi24JPT6+o1UuS3dKz+ADkIF2NjtEHANoZS7HVJEpICesUv0bHvc
-cp1VkeUposQQ129YI7YQ7Wa0rse3dfvW6N+YHCPCTb2qnaAw0ipZYktrxnKSQrzbPdtO3zAOFt77/XxN
-NLz4/tJvvqlJuq1BAbe6C3qQvnvfwrH391BDhNhzgYVbHYUD15GzXhrR12d9Ped3FQy0b6ZO9WytdvKo
-d8sYpSCCZrvdEjYttiFrDm3so4JQykRqaRyy7cJkQGhgRKPaxTFb+cbIZN3xa06SO/T2qm/u5p4ZA6pv
-6nVOGO+b+BVyue1wWsQHeexMIOvCrCV9WA0Pr8pKrajqaktXHttVz7aIDe1f4JR/S8+uqYxwdo2zxokV
-wW3dpZ/uwClwMOCBc6IL75R5X7IqRFUV3u9nNCzuQTaJj0idL3/9sQZKSYVXC1Yv/COnDNk/E68FXi1i
-tjougN061+CC9iPmOPX0uRwlkuuCiCV+gVc/UYoIEnCPvCnOET9PqrGCDzvc587uoeuxI9Yw9w5vIo/a
-PgH3nkd4zukMVsjoNHU+uvyJBpHSyI1MSo3iYey0DScLuw7HZryf6Y8+DK/wUD7Gh+QRn2b8BJ+ben4g
-H8hdqe1wqUb7eZQa1I9uxTSReT+npzSbE80o6Mdbzp8o7Y+0o0b8qeG3iIMUvYhdTQpKX/2vLn+lq6i6
-Kag+IKrGjjJdcPIwE1LA3D+Byp73FWFfAr/IM42GqpesE3YIROIdkZfcsIIo42wzsf0THtSId7rWtTRG
-5rMfXOPaGNGjqyKyYRxw5X9/3UBMaz/gpfGWbrnw26CnzeXoeZhtPn6RlPD2w6+nMD+cxMInYB6WXUzZ
-lmo4olq7dHhq+UQftpF+sfAtpeWlBUnuppxpAwLUaFd5W0gKMyqTMgdhpimY1xzs5cuHt3QU9ZybovFl
-tRW2IMwscnaO6kFbT1NiYIY2pXBIH1kWY7RrIlaBKZVwnAOG+uXDDUltNh9FLE+j8bza4Cvp3jNoiB4I
-6kzev6bM+Ji8zqTx7vYK3ytSjEzG9Pi37z5UNC3F8dx2wjW5k0RYntac7BI/WHM2zHCYochuDFJCYwub
-i95b+yKWp9OESwH2dmRUCY0VPaT0DP126CEayleuO4kuPRxmKDrqaqJLVEjNrBIzFDlIN/ztz3ln1mc3
-/7tngsr7KZcJcYZYorOJybdLMf6b1cjmHQ1mao+B84DqoffZX/Yo5YOpX6ledWolmlBuOHzw/nR+3bcc
-2neIqlRnm5EriPpVZtt3Ohq3wdR28sHNt8J2RBT5bTowsy7X9aJg1Lp/hvC/ZRltAeWEAtIyB5T4/ZfW
-9SgjWxCRQZ60ySBHD2Au7RH1QZbIhh0TJdiZB3TPOEdrQFxqM8UBpwZDoXPb9u4RG52D1RMwpGALylT0
-R+N5a7L3NNtaEWboEyjqU+tniV6SIPOc0qeqHH0aNZwPrD7UWafOFf6pSi3Alijki7PVBC3RydTZ11xE
-424WmA/BXbfn6Efe6OixaHWmHHcw6VPSQYuOxiiYmrpaM60q1xKvuUzucL3upO651WRieUXjikQGNpaX
-0d9f/FB8jA55uB21nbiszB7H6FUGyZ0NitTqRP17Mhc8VTjROrRPClW/PInGU8cG/WWJorMvUKIx2tXV
-ynprXmHyMS6cibsBXKq3Fme46ESV62ujmEjPc6Sgk5rj2LIMd0ZD3hVE4xNihMY/nFLbLlgDCOSe+Qzw
-Q+tw1zLTp9i7e3D7cgSrE9qXI+iOWoPIVUMbwjUcl7bQt0wUTVkTBVrav1MF7pw3in9Xv4s4vUQYV7Hd
-t6a9omLNRHHEt5PmD8H5K2gwrci8OJsejiLx0UCcP06wFXSPxtwAgmFMnSbYiaJ52yZBwPiXJ+fZ9oeG
-Bcp84MZuFDxlbxfwT9nbxna9s42fo+8mvlo/9mfnZX/H36n8z7ZdJwHY/WLHdVhE63dMmymhdNQr03g+
-iG7PhzYt+sq9P/gsFvUHM710/ZPyT5K2S9KZok1vfzJAg++F/gzRbxKi4Rda/2dB+gWC6LE4/dw80Buk
-PRH1WRHaH/RNkLLNqPOoZpoRnaHlEkV/rWhGNfZOwGp/sYjrB4L/DQAA//+F1qt6iCkAAA==
+H4sIAAAAAAAC/+xa3Y/bNhJ/379iThdANm5t5S7XPvgLbbppkEObW3Q3KIq2D7Q0ttilSIGkvNka/t8P
+JCWZlGWvs0lzOFz3IZH5Md8z/A2l7RaeVRXNYDKH8S0WJSMar4gm43fv3lzBbncxy+gGUkaUmkcpco0y
+WlwA+MMMV9oOAszyF4vtNqT0lhS4282S/EWzRkJiaSQZ3Swuamr15LLSWnCg2TzCjOrRmhQ4coMRCJ4y
+mt7No9/IhqhU0lJP1kKLWyTFa1LgLVkOhtOoEaysZLN3pMV6zXC0olJp8Cb851EpaUHkQ7QwxOAKNaFM
+zRI3e0RAjaQYFVgsUaqzBP3ern1cVEZCSaOF2Q1ueyBVaMiO7TRZRr6nYEnXI1WSFBufrYQsAjnsQPs0
+IoyuOWYRkFRTwedRYnRO2tjZ7RJFNmi4RVCgzkU2j67/fXNbMzAsKLJMoW4GAGYEOClwHrltiTeTv3Ca
+GifswwagVs+XNBVcS8FGaymqMtovBJgxskTWRm69zg5GsBLSMTYi1N5+a7nZBQEdystKW5u2GzzJ3e8N
+YRXOo+22k0SGsI1/2O0iKBlJMRcsQzmPWp6e1LUf/zBtGeV3tbY/4lJRfYbCdo+nsPt9UuHvKL87ovB3
+VoLPpnCGKo0WV+gykArep6/G95pIJK3KdpensvsdKOORHI/H0aLfEN4qMBWw4fT5DLCSpMB7Ie+ixbfN
+Y/KKryk/w/X7zZ4xvMGTQdCyOxIJXXGeGhUgUaEelRVjoX0I5BJXPcXKq7mMjWxJtOXw+KnACOXR4hvC
+U2SzhARs6vX6ocR5pKplQXXIQNJ1/iiH5tx5V2ZEY135/GPnwC6zJKyps8TU6sXF4xbbV+UTIbS4SSUi
+V7nQqhMph4hgRBiDXEj6u+CasJFKpWAMdF4VS04os/wJ5SgjG1x9Ew317RboCrjQfUHlCWWwiedrpR8Y
+zqOCyDU1J2g5+efz8v006hH0MSfUceOd3O5AflcyQbKbm2+FLMzRvXADsBfKi43tFpAp9KXcbkESvkZ4
+Ri/h2eYQcp1UkRZryIgmFm8YIwYRbWeUasY3DXxr9W9NHgFhulkVFqgIlExNwjRrgyO+Q1fJVGFL6LbZ
+cmOH3Qr6O6p59I/nz8v3wQFvbMOzvXbd30Gcu3g411B+bNrEiwLEYYPvwJkvNe/DYX9ccHQV9LRvp45h
+ugBuHmC7nGYZcg+Mh5CxheCaLBmGuQElyTLK14bGvhrPdI4k84yoZXh45gsHnHTeHb9hJL2DN1d9c7f3
+VGuUfVOvCkJZ38R1tWQ07Zv5AQux6cgwS/aSzpJAi5leiuxhcX5i1vYL8rFrh2zhMqFGe7NEZ/0LrFne
+ZCfX1OY5ucba6cgK72eD749jdxdMpbVuB7+DptqU1R9z1DlK0Dk+xBKBUaUxA8HNCLitQGSa0w1GIfcW
+VbgTsg7QGlA41jTzsYRXZJIDUqdPW69VcmXj2WbsogZ2u59Q7YvyW9Gm3eE52z1Om5GPtHOGDDU6lY/0
+Sf9FkwXVDqUUMlrMaLPw9yKjYP4ZOS2ixSyhi6eZzs/Mw+Lfl1qQCqZKwufRl9Hi6ywDAhzvwZniFPHT
+pForuMIX9bmz2xY/1gSf597zYf4BMOd473j4nWhnsI6MDux2Vcz1nEAqLVYirRQk57FTpmyZsOtwbMf7
+mX7lyt1VdC4f7UrfAZ92/Aif22b+TD5YWDDU4VKP9vOoFMqv7IpxKop+Tg5S2/6rJdqU1sDjOaZ3S/G+
+x9n98jZEOgI3wy69W6qNBlpW9nIFvqNKm2Jd9BftQ0UOu8MnNDwjRTNUj7c9X2dZfy05aAY/tMDMEu+w
+nyUW93jwqvmvgViVRW2qBW0u5evmIqOqZORhwgXHqbsFzV/0AT0Hsz7JvVpL1UnWPZ+Rp84RRcU0LYnU
+1jYj0w9EZzWDnc5pKbQWxeQL2zwtLoJ4rImsKMMm4NxzG5NKuQGSpljqeUQLssak5OtL9/Rbic3jmq7q
+p3tcej1pubh++/oS/nX96vUlvH7zLQhpLquux9D2GAqIRChIhkAUXL99bda4DbhBDqt6C9TeHM+ScnHR
+CabPE8chl4PbYQO1vxcZYeFV8Icw399L+PfBLkG60W0aiPNjO9il/B7+idEU5tzFzDVQhpfiJL0bW1TJ
+UQ62dTBwkeEkE2lVINfjNepXDM3jy4c32SDuuUWIh5f1VhMIehJbO8fNoMEua6JxAquK25wbGBZDaBgC
+SNSV5Jazx1C9fLgla3NyDmJarOPhtN7gUMvOMWiJ7gmqXNy/yqh21eEmF9q52yl8L0k50DlVw5+f/1rT
+NBSH04uLPbmjRGixbjiZJW6w4WyR+wRis9ErTq0tTFV8a+wLtFiPUyY4mp8Dc2K0VnQhpSbw8x6vtZSv
+LBKML104TCA+QJDxJZRCUaPEBGIb0i1/82e9M+mzm/u7pzwT92MmUmLmYQ4nS6SDpkn0N6ORqYAK9dhc
+ikw9qnucubvsUcolU79Sveo0SrSp3HL41fnT+nUXOLTvyqBWna4G9uhW3+SmJc0GwzCYQifv3fyOG/SZ
+gdumPDOratks8kaN+ycQ/SSqeFPXUiUKhNTtvzSuh5xskMcaHGmLHh5QXwJdwYOowKQd5RWamQe4p4zB
+EoEJpceRx6mNId+5ob17xIZTYfUBMSRxg1LX9AfDaTDZe3cTrPAr9JEo6lPrtYCXxKs8x/SpT44+jVrO
+e1a/1o+7pla4drYRYENkfeQZTWAOR0tnH8yJh90qMD0n7rropz/yBgcvCep7kmEnJl1J2mvR0Ri8qbE9
+a8b1yTWPlkykd1Gz7qjuhdFkZHjFw5pEjiaX5/Hfv/yifB/v63CYtZ28rM2eJPCNQd0mKcz7EcjcW2Ob
+PHU6ZU1qHxWqeZUYD8eWDfxlDvHJ14nxELbNaWW8NYXdWVzM+7szuNTv8E5wUamsljdaUr4+zdG8Pms4
+Dg1Lf2d8zpuzeHhEDN/4+xuB0AVLRA72hvMMPwSNdGCmp9i72yR/OoJ1N/zpCNq29ixy9dCKMIWHR5vv
+W8rL9ljjJczNv2OJtqceJL/IX3iyvoQoGk6PrglX1KwpLw/4dsr8Pjl/QIU6yMyLk+XhIBMfTcTp4wSD
+pHs0584g6OfUcYKdLJqGNvESxr1KPM22PzVMoEzP3NjNgg/Z2w34D9kbxnazM4yfg6+I/jA89ifyMn+H
+X239z8KuowHY/X7NIiyilLmLG5MsG/TKNJyeRbfns7OAvrTvxD6KRfP5WC9d91biSdJ2SVpThPR2RxPU
++3ruzxT9LCnqf6/4f5aknyCJHsvTj60DvUnak1EflaH9Sd8mKV0NOlc145yoHOZziP9a04yb2DsSVruL
+WdJcCP5nAHVaU6qWLAAA
 `,
 	},
 
@@ -8378,72 +8648,75 @@ xZp9P7lIE24om/JaAK//+Gjbtr4Wr7qqD9fb8RMAAP//0JA6ihQBAAA=
 
 	"/templates/public-voting.html": {
 		local:   "templates/public-voting.html",
-		size:    13726,
-		modtime: 1571429152,
+		size:    14605,
+		modtime: 1792302413,
 		compressed: `
-H4sIAAAAAAAC/+xbX4/bOJJ/709Ro51budH+08ns3EO37UPS2bnNYpM5JJ0cDkFjQZtlixNK9JGUHU+j
-v/uhSEqiZNlx5uYW+3B5CGRVsVh/fyyS6sdHECsolIXxPeYbySy+YpaN75HlBp6eLgCmXGznbxWsWY4G
-MrZFWCAWsNTILPLphOgXj4+A0mAzBJaSGTNLlqqwWNhkfgEAMN3MfxLaWFhmShkEm2EQbDNmYa9K2LHC
-glWgWfF5SAwFbEpLDzmIApTmqGGlVU7cGnJlLKzYVmlhkcZJZNGb8XSyqad+Uey7s3FVpNbNBTshJSwQ
-lqowgqNGDqkVyGGlNEgSupFsiWklMlh+0tzs+fzZGO66xnbtnE6y52GEZQuJIPgsKQsiIR+5V0k1w6bU
-6F9B8zhaOL8ghyUWFnWYn+RlyHj1i37r5ocjz6cTm3Xf/TvLEd6yHPuIlBxHie+XGrEwmbKmTZ5OmqmJ
-Eqk1tQvF9w3r4yN5ZY3wvRjC91u4mR1Pz9os5zOLLNdqN3p8/H47/vDh9aunpwQ4s2xEFOKIKW3deeXh
-2vFsaYUqTDKfspb3F6W1qoDoebTRImd6n0CmcTVLfmFbZpZabOxNrrZ4r945iYM0mj69vE3mU1FJ/jXn
-Aui/0UaWZrQUeikxmU8nYg4vOKdU+agsTieMIsaP6L5VVhTr0VJJl2qjguWYzB8f4fvtmII6prjB09N5
-IshpLRHfNNopYJp8CJEwZqnKwlIoJBa1YlHidELjEiLAVA93nAdOmR6XipytK2cO3iqIhl92Z2pwrPX6
-e2MoEUXB8UuvGtcHijRZU2WHZQsnYJaMnvXkisnULhJ5mC4t+WRqvoYMxTqzs+TH6wSMXs4S8vKNs3ji
-9R7/JCTa/YbMul0wg//6p2Gg3GdlviiYkECVMplPFxomh9N8xaOPj3AklPD0dAl3Uiw/uwQWuGu7aMIO
-Io0Fb3uynW0xjhzyTycRlkwnDhy/Aayfj+Fnt8C45ebsVaYXv/8x6P3OLR//EATvWy7ORvWvB2aldA4e
-cmfJZKssJqAKUy5yYWeJRlvqArZMCs4sEhQO6oroCSpkTK4aR2bP5z+MCUcFyWcSXhc0H6NfTfQo1Qvq
-Ntz6a1B7Lah0ZkkmOMciAcLDmLplssRZkjR1EwmxIkdjWb7plxKRg5jHx85SV3H4+qxmiAxWG2/SSqDk
-EURMJVugpO5llnBhlmqLep/M/6J2wAX33U947VoTypi/svzfphM3sAsFkVGNtGCUxS+2MikiGruXOEt2
-gtvs5tn19b/EFlRx/y3mkOO1scyWJpm/0OiMYTfHNI9miizxqmvGhUqcWRumrViKDSts0kFAgGBdPHMV
-snhcGzxjnWOu+X80Pyqlo5Jq6Xuu9lsly8Ii6m/SvRl1XPOGZ/6xevzdtBZGWPWNOocxsMxw+Rn5Cc0D
-5/yjfzitdfQjfjwHW/40hvcOqPxyQVp/F8MKwDQ0jef1kWA2bImjLVKaMFnVmQfDZB7m+uin8UM7ik8n
-hG/0dHY3DZ3l0elFJmslR2utyo2PWdTSjXxbSB2KayBCxXNhNpLtbwpVYI3R2Q89fSgs9tBpLbMfwgAf
-yMj1pId7mczrlmIrcAdKi7UgVDfiV2wFuRU9t7iOmJSQKS1+VYVlkhpUJSXYqhFyFjNRUEk4BO8hVGGN
-XJuvybsiXzsHn2xTp+yw88N8IdZrLJoBrvkjeb39n+v8/H4GGi7o2e604hMa8P6BwU21wQkwaSPGV+iV
-FarwwT5oNj1f1W22m01H6rabFwdtYLuhi9I5PMR0F1yypfIecu+/95mytT1+xYVVKaXPXGorlpQ/rQhw
-tSxzLOx4jfbPEunx5f41H6R9wtPLsZP+N2HsmHE+SP0sfk/ndZ16wfOLLdNuJ2VgBo/wdHtx/v7WDfsU
-7QIeSMYFQNJszW7Ab2qpgJ6ekiFRm71fRa2LrmKJN2Y38CnKZWNILWNOZHLYENXJM2ziAg8X3sQQpotV
-WbimDrq7G5uXpeCXzh7yUaQRzOBoPGL4Sa+CkPFSqgLfKo4Dq0u8vL2AWN7YAdM44NIsTR09U7s3ijM5
-eHTJZoWVeANppGM6dBRTLgLRB8RP+jCu3ezZqMElFW7iqQPJYbS5gU+PIdGr2V6pAsM0ABtlXIN6A6mm
-XV1NcPl6A5ng6FR2r58eLgCeLm8vYi/3IInI15GXTzmXOMk1YjUgvhkUpZR+LMBkAneqlLxILaxEwV3X
-2Fg6Ho/hz1or7V2G9ifJTPYGjWFrHKSO5OBaFGt/ANeMHYJRWu/H43E6hE8pEm86TFeMi2KdPjidAPwe
-gJ6fgjE1JJ9KmP4CJjG7TEgc1ELGGTN3mZCcgmgGl5XhDYPGXG3R8UTDVkIb615etpSjmN2rO6kMvjHr
-WEV/eBq0HKRcbL1CnSFjURSo7/GLhRkkfs17TVhLK59jSm7DZOp1fmKGROTrxM1AfGOD9oW1WixKi4PU
-6GU6hHTiUTy9MmZMqG7Qjv0ycpW239ICckwYkzYdgjEUhc7rS29i7Ta22WDBgzPbhp/gpDk75AaKfXwi
-NG6VRnvzWFeEMB+JADMYrNGGk7rLscRibTOYw3VVEt8FziovDnL8v1QJedkcbzMbDgpU4Y9+T6Q3ZU3Y
-5B5XI7am3DhbBO6CLf7FS4c07y2zlMJVevjDiDuUksrfFGz5ebzTbDNI/xCfU8z9Jt3queVjIoyWKKXP
-zZXSA+cumMH1LQiYxlKDnrcgrq4q/0TkT+Khlczi6llltEH7waCmoHykBn/QsfOQ7sQfLfdqZ55ejv2G
-ofbm3fuPQfhkAvG5LFj22d0KIBCwg1oBc/GaUAEAK7hjD/cGRKACtBmSoIWyVuU0hsan3ugUnD8bK1rH
-wDanddPbMZmAuxIZNphKk6YGtNpV0VO7g7BVR920BDppIUmJN06aEIsgBmZO2Kfrh74V07NxlBQzzxq5
-17zc31GlURcxSLun5GmQIFaDIKBHC4iEh6dP1w9+oO/4qrGhkiO1XGp+PXnTy1pilfj3/AT2Wl5p7lm7
-jV2nCsA5ZaOREGngh0QEj1QDP4eP9gvvn/s67IHdaVsN0Gp3WQlx0RF8lnZDfJWOvFvSxj7G+UtbRGH9
-7xL1/j1KXFqlB+m4GydgkSFuhO+N1IYthd3PrsfPf/R0L7pVtukLzpF/l7YYaDNDtPT2ooLFe5GjKu2g
-Sv/BZRX/atJ2fJ+GP15f1zAYI1sHC6Tg+E7tPmzIY3Hz6HO7DnLHC39Irzx/Wxyp4IP0YdMqymNF14uV
-aY2yRZm/86OiKqyI35q/x2t5MoG/0iojldqAzbQq15kDDqcy49x3WJgHlApYFIYKD1R/L/CL/bvzmzDV
-aHfXaTN6gSwfkiwQFgrlj+17loBgcgv3ne6VL+Yg4I9/9HEXV88e4DvfVUZHNjG503pQDxoHxv+bTOA+
-QyAL3NIaDKDHHaYaKbDkg3ITD3nhSi02XhUYrpidzarAmr9bnV67kK5HGGLyZAKvi6V2IAMCclaUTMr9
-EIwolqQmMKmR8T35GL1Wzh6tmisScXVVSXzy91ItH/xsM9Q7YRB+oXSgYIU4nqflU427p6uur7Goy6Tc
-vLRFt1DGIa+pwEbl5mgD4Qf39Q5iNRAU/Gjp8MzUSXBhKKP5jFaugCBt//Twrpg0eNsy2a12ald8zQLi
-OWpDJeCUFR2e0bNoQQyk8+zq5T6w7AjIvVK74p8R5hqo+J0g7j+ppHcImbDHkI2jZHvCtsW+rvtvgbdQ
-S0fhikAtgsAD9BLmKGpRjP8ft347bv0fNvdVM3Wkvf8QyP8UDf5tVQ3vXKvlJl66DeAOw3dPBSJvOsml
-PaPtP9r0h+G9TX8jOjx1mv5q7GHTX7fvX9Op1aKTl3wz36dNI9M9dHQJA9uqUBWGLI1ceLAd8G1/lQXH
-Gn8SVvA47SsQq9IrQrOjkNr+POxg73Owu7g9US5rtJXS4QzCACuAac32lPvRR3MZ2yKljvsGMAzZoyUp
-A0LTUkjui82Pdt9Q9NXOZVM80fShsQgHITTvPTHfqxckrdl1prHu787S3DnYoIaMmaD5GVqf0PndWRp3
-9b1TxRa1NYCCYLA9i9IHfqLciC1Si19waQ2JSu1CpmAyVUoOC6wk9kuLVO/qaBcy6geQNnqfHur1XB8g
-U3plF/IqPegPPD5Z3QdP9BbZMmu2hChxCIJ/aS2rKLFaUquNAq2k0etqpf2ufTgdzoepqtGOl6pYMjtY
-o71Hlv+8+GVwKOGyu550j+E02ijJgqA4y3wofGdBS0lY+X3ytNeHSJODBsxpTbbc/r6rAUrsrgZ+rrpJ
-LgW/CVpXFw0Fy/HG+fsY2taXHg5tmuOBSgJp+varUur7qyNSjLlTZWHPUCW6a/GymmNq96GhD+7J2NbH
-g7ApCd3dl7o1rvjSoz7BtSt1AgANUCuSUS3q8PqVAVEDo/usy4FM1fBlBPZU8e6EEjbKWMAvG6poELYP
-X9yhZZMsMIuh57aVQ0ly7Jy293S2qha4Ak2tLKXDVTJMjruq91grvPVeszwsE4oAlUlZtU6+YXAtlb8K
-c2XDOgDrWqLaBydP0WqX2HNO92zvuV63kan3syckevU9v+M9Irja+g4hjT7w6PwcWbVeSxy5G6QuLXwL
-Ek/lyuQv92/+BjNI+z7LXDKNdlRu2t8Pv6H+78PG33o6VJHCWCxQh6vPwt1aujl8AeIWqfhSdy+TUkIM
-oTnMCxnUd3x2e1EhqeWtexsnvPYyL873suM95WW3PT/Lz7ngXOJJR/vZznQ0zdzjatpof83Zbp7f5Ox4
-G3/U3U58426U3+Bvx9xZLWd+wobcH49wPn1WMCQ7zHl/NRar8fVIuHtQ7/13zfH4Kdc7yV/3PVTH1v27
-u+O+d/IdJWCo5W0IPdgiVH9O0wOibrmI/gIgDQjaxcmj247/LVL23/S0Lh+OCQ13DeGi4FhGBXJn2t+W
-QK1ajm8wTqXQyb+4SCNJ4arj+N93JNVVTZJe3iY9ueEFHebGdFJ9LtR8OvM/AQAA///oFB/TnjUAAA==
+H4sIAAAAAAAC/+xbX5PbuJF/16foZXyhVDOSxrvZlxlJV95xcnEqu0nZY19duVwpiGiJWFOADgAla6f0
+3a8aAEmQojTjvb1UHs4PLooAGv33140G5/ERxAqksjB5wM22YBZfM8smD8g2Bo7HAcCMi93iJwVrtkED
+OdshLBElZBqZRT6b0vjg8RGwMNgsgaxgxsyTTEmL0iaLAQDAbLv4k9DGQpYrZRBsjoGwzZmFgyphz6QF
+q0Az+fmaJkjYlpYeNiAkKM1Rw0qrDc3WsFHGwortlBYWaV2BLHozmU239dav5KG7G1cytW4v2IuigCVC
+pqQRHDVySK1ADiuloSCi24JlmFYkg+QXxc2/XbycwH1X2K6cs2n+bVhh2bJAEHyelJKGkI/dq6TaYVtq
+9K+geRwvnV6QQ4bSog77E70cGa9+0W/d/HDDi9nU5t13/8E2CD+xDfYNknOcHXyXaURpcmVNe3g2bbae
+TVtszexS8UMz9fGRtLJGeCGu4cUObufn3bMWy+nMIttotR8/Pr7YTd6/f/P6eEyAM8vGNEIz4pE277zS
+cK14llmhpEkWM9bS/rK0VkmInsdbLTZMHxLINa7myc9sx0ymxdbebtQOH9RbR3GYRtuno7tkMRMV5V82
+XAD9N94WpRlnQmcFJovZVCzgFedgFXxQFmdTRhbjZ3jfKSvkepypwrnaWLINJovHR3ixm5BRJ2Q3OB6f
+R4KU1iLxVasdA6bxh2AJYzJVSkumKFDWjEWO0zGNc4gAUz2zYz9wzPSoVGzYulLm8CcF0fJRd6cGx1qv
+XxhDjigkxy+9bNycMNJ4TeUdli0dgXkyftnjKyZX+4jkqbu06JOomzXkKNa5nSff3yRgdDZPpjYvN0vJ
+RDH1ViMCcDxOvRTVzwSmi9lSw/SU6hMKfHyEM5aD43EE94XIPjt/Fbhva2TKTgyLkrcV13auGDZO58+m
+EXTMpg4LvwKbv53A31w+cdnl2UmlF67/OWD91mWLfwpg92WHZ4P404ZZKb0Bj7DzZLpTFhNQ0pTLjbDz
+RKMttYQdKwRnFgn5hnUAeDhop4R7ZnGttMAaD86b3hv/uwn8nRzVWbwyrXHZHlmWA9szzRtTx2kpo7yU
+9SSmUy7afKgtycuKlcCCR+E8y79zCJtFEJt/15cRNW2t3dYvevc+kIu0k2PBlliQYPMkY5ZUPQ6bBShw
+P7UgUHBs6J3jwK2LmDRYYGadtz9JByhtnJvXzrxeKWTrEudJsiCAvs+VyHA0m/rBeH6jC0u6sH26OCkQ
+TrYhhmzEUPgd50gYhpfh9zluOgg2m3o9XUKtEASng9FQZ+DUmyFnxarl0k8Gxh+a/PZdTX9C9YXwjglv
+JAUmo1+x78+E3Jbe9KVB7cPVHrY4T3LBOcrK4M1oZU+Y9hCxYoPGss22n0o0XNurY+FqxvEY7/CcWIvC
+gQuTqR3qQ7L4s9oDF9yfCsJrV7KTS/yFbf69iodOzoyEaqgFoSx+sZVI0aCxhwLnyV5wm9++vLn5t1iC
+2De+VhxSvDaW2dIki1canTDs9hzn0U6RJJ51zbhQiRNry7QVmdgyaZNOqQAQpIt3rkwWr4v3bfMcz1r8
+vflxCj/TFr/P5X6nilJaRP1VvDerznPezFl8qB5/M66FEVZ9Jc9hDWQ5Zp+RX+A8zFx88A+XuY5+xI+/
+CSB93wDSHyJAeufKAJ+aSdRvYiwCmIUT2PMOZWC2LMPxDsm3WFEFpy81kkXY64Pfxi/tSDubEiguBnHy
+eeJoCp3i0/FFetKqGK+1Krfe0NH5aNyq1muY4MJsC3a4lUriXV28ftdzqIPlATrntKqICNaP7EV8uJfJ
+oi7YdwL3oLRYC0oFRvyCLc9omdyVrmNWFJArLX5R0rKCTnuqKKA+gDiJmZCovbR9A6flldisSbtis/a5
+/dKZb8ZOj1G4WYr1GmWzwJ2kiF7vYcodo3xzAJpZ0NM7gBfdMWP6FwY11QInwAobTXyNnlmhpDf2kye3
+Nn2jM4MxwYdq6Ts34ieJX9DMk29vbrZfWjmGPVF91A/xuDM/SVvpF7nX8Ltc2Vpin8hhVRaF920q6zPy
+sJaNuMrKDUo7WaP9Y4H0+MPhDR+mfcTT0cRR/6swdsI4H6Z+F99C8bzOPOHFYMe0a1wYmMMjHO8Gz28n
+uWUfo0P3J6IxAEiaTsgt+B4ShdjxmFzTaNNqqUbrsKymxH2QW/gYebsxxJYxF3w99B9q8183doFPAy+i
+/zkYrErpDlXQbSbYTVkKPnLykI4ijmAOZ+0RA1R6FYhMskJJ/ElxHFpd4uhuADG9iYOuSUCueZq68Vzt
+f1ScFUPiAMAKW+AtpBGP6bUbMeUyDHqD+E0/TWo1+2l0wCQWbuOtw5BDcXMLH/1ezW6vlcSwDcBWGVf3
+3kKqqYlSDzh/vYVccHQsu9fHTwOA4+huEGu5B2vEZh1p+ZJyaSapRqyGNG8OsiwKvxZgOoV7VRZcphZW
+QnJXjDaSTiYT+KPWSnuVof1TwUz+IxrD1jhM3ZADdCHXvt/drL0Go7Q+TCaT9Bo+pkhz0+t0xbiQ6/ST
+4wnAn8Hp+RiEqUH7ksP0BzCR2eeiwGFNZJIzc5+LgpMRzXBUCd5M0EjdUzcnWraiGwT3ctRijmz2oO4L
+ZfBHs45Z9HcVgcthysXOM9RZMhFSon7ALxbmkPis+IYaX2AVuEnJXdhMvdlc2CERm3XidqB5E4P2lbVa
+LEuLw9ToLL2GdOpaatP0ypgJZRKDduITzVXafksp5hwxVtj0GoyZrE9ej7yItdrYdouSB2W2Bb8wk/bs
+DDdQ7O0ToXErNNrNmzoihPlAAzCH4RptaIyPJgXKtc1hATdVSHwTZvqlPT7+X6qETdncJjEbGnVK+puW
+C+59HFQOfoGNWJpy62QRuA+y+Bc/OKR5Z5klF67cwzcD77EoDMzBSJZ9nuw12w7T38V9woVvklm9sHxC
+A+MMi8L75krpoVMXzOHmDgTMYqqBzzsQV1eVfqLhj+JTy5nF1ctKaIP2vUFNRvlA54ZhR87TcUf+bLhX
+B/50NPHnkFqb9+8+BOLTKcTXIGDZZ3cJh0DADmoFzNlrSgEATHI3PVzT0QBYRdOJ0FJZqza0htanXugU
+nD4bKVq3LnZDedPLMZ2Cu4G8bjCVNk0NaLWvrKf2J2arbpbSq0AtOCnNjZ0m2CKQgbkj9vHmU1/G9NM4
+FmQzPzVSr/nhcE+RRlXEMO1eSqWBglgNA4EeLiAiHp4+3nzyC33FV60NkRyx5VzzaedNRzXFyvEf+AXs
+tbzi3E/tFnadKACnlK1GQqShXxINeKQa+j28tV95/TzUZg/THbfVAq32o4qIs47g87Rr4qt07NWSNvIx
+zn+wMjLrf5eoD+9cw0/pYTrp2glYJIhb4WsjtWWZsIf5zeTb7/24J90K2/QV58i/SVsT6LhDY+ndoIJF
+aoap0g4r9x+OKvtXm7bte7z+/uamhsEY2TpYUAiOb9X+/ZY0FheP3rdrI3e08Lv0ys9vkyMWvJHeb1tB
+eS7oerEyrVFWlpu3flUUhdXg1/rv+VieTuEvlGUKpbZgc63Kde6Ag+aTVXyFhZuAUgGLwlLhgeofEr/Y
+f9ASEKZaTSAPNqcXyDbXRAuEBan8tVlPCggit3Df8R4GYAECfv97b3dx9fITfOOrykHTCYqHO6UHzOcQ
+G8b/m07hIUcgCVxqDQLQ4x5TjWRY0kG5jZe8cqEWC68khi86nMxKYj2/G52eu9HdpQnx8HQKb2SmHciA
+gA2TJSuKwzUYITNiE1ihkfED6Rg9V04erZorSnF1VVE8+q5USwd/sznqvTAIP5M7MO7JxBQucnmscfdy
+1PUVFnWYlNsfrOwGyiT4NQXYuNyeLSD84r7aQayGgowfpQ4/mSoJLgx5NJ9T5rob9OinZ+6KFQbvWiK7
+bKf28ikJaM5ZGSoCl6TozBm/jBJiGHqeXL2zTyQ7A3Kv1V7+K8JcAxW/EcT9J4X0HiEX9hyycSzYAYSl
+FmUV918DbyGWzsIVgVoEgSfoJcxZ1CIb/z9u/Xrc+j8s7kt5sbx/H4b/JQr8uyoa3rpSy22cuQPgHsNn
+hhKR14tZZp9R9p8t+sPyHs4gIh2eOkV/tfa06K/L96d4apXopCW3sJebhqZ76PASFrZZoSgMXhqp8OQ4
+4Mv+ygvOFf5ETPLY7SsQq9wrQrOzkFrKC6Dac7q4uxAua7QV06EHYYBJYFqzA/l+9I0qfW9LruM+uQ1L
+DmiJypDQtBQF98HmV7tvmPpiZ9QET7R9KCw8E27fB5r8oF4RtebUmca8v30W507BBjXkzATOn8H1BZ7f
+PovjLr/3StLFnwEUBIPtXZQ+0RNY1ZJILX/GzBoildplkYLJqSkLS6wo9lOLWO/yaJdFVA+ghTl8/FTn
+c32CTOmVXRZX6Ul94PHJ6j54orf0FVNzJMQCr0HwL620igVWKbU6KFAmjV5XmfabdnM69IdhTv9PMiUz
+ZqmPRlcpf1v+PDylMOrmk24bTqONnCwQir3Mm8JXFpRKQub3ztPODxEnJwWY45pkufttswEW2M0Gfq+6
+SC4Fvw1cVxcNdJtx6/R9Dm3rSw+HNk17oKJAnP70JJX6/uoMFWPu6aPcZ7AS3bV4Wk2b2n3X64170bZ1
+exC2dEvoP4yvXofQY5L7cqV2AKAFakU0qqQOb14bEDUwus8qHchUBV+u9j7iXYeS7nss4JctRTQI24cv
+rmnZOAvMY+i5a/lQkpzr0/Z2Z6togSvQVMqSO1wl18l5VfW2tcJbrzXLQ5pQBKisKKrSyRcMrqTyV2HE
+J7AOwLqSqNbBxS5arRL7nO6e7e3rdQuZ+jx7gaJn3893c88Qro6+15BGn4B0fo6tWq8LHLsbpO5Y+Fok
+3sqFyZ8ffvwrzCHt+yw6YxrtuNy2P9f/Ue0Q3m/9radDlUIYixJ1uPqU7tbS7eEDEHdIwZe6e5l0AHC8
+hqaZFzyor312N6iQ1PLWvY0jXmuZy+drmcsntOyO58/S80ZwXuBFRXP5FYqmnXtUTQftp5TN5a9VdnyM
+P6tuR75RNxZfoW83uZMt537DZrjfHqE//SxjFOzU5/3VWMzG05Zw96Be+2+b9vgl1WPxLN1D1bbuP92d
+172jP7prMNTyNoSeHBHCe94DovQmjf7gJg0I2sXJs8eO/y1S9t/0tC4fzhENdw1+3lmPCsOdbX+dA7Vi
+Ob7BuORCF//AKb0bdK86zv85VVJd1ST0KVCPb3hCp74xm1afCzWfzvzPAHrzzK0NOQAA
 `,
 	},
 
@@ -8458,11 +8731,17 @@ H4sIAAAAAAAC/7JJySyz83QOUXBPzE1V8ErMtdEHiXABAgAA//+MadFWGAAAAA==
 
 	"/templates/unauthorized.html": {
 		local:   "templates/unauthorized.html",
-		size:    90,
-		modtime: 1570465672,
+		size:    808,
+		modtime: 1792304011,
 		compressed: `
-H4sIAAAAAAAC/3LOyUzNK1EIzUssLcnIL8qsSk3Rs0kqUtC344JSnmkKlfmlCmmpqTkKJRmZxQoglKeQ
-WlSUX6SjkJyfV5KYXKKQmKeQmJKbmZdZXFKUWJJfpMcFCAAA//8ZCPIcWgAAAA==
+H4sIAAAAAAAC/3ySTW7bMBCF9zrFg1Y24EhOgXQT2Rt3U6CLNnAOwJAjcxCaFMix1VTw3QtRcpKiTQFB
+EP+G3/dGjeEztFMpbUpNXiiW2wLYOSYvePTqJDZE/kWmap4i6nHx9WMYwC2qPR07p4S+KFHVLhjC5VIA
++4BOcYRYTtC54ArKQ5kje2jlkcbXtBwMrRAiMgLEUj7L/pCXEHyem7ASOnWg6gOAHw9vCO/tUqc03Zwp
+CmvlsiXQ8PGAFPWmHIaP6pRQTv6x4bvi+PjwLe/o2YjdlJ/uPpewxAcr82AKrDZ8ngMjb2Y2e3tFm1Vv
+RtVy+9dFM0dT29tcrdvuLU8hoGfn4IIyOZ9zkDGyNsQjgteUJ6fokU9wHBtZd1cYl+akvrZ4CSe0RG7q
+yPh4UIwhrqCDF6XltXucJCoJsfrDadb831/RJB25k22xaE9eCwe/WGIogLrGzpJ+xpPSz6AzxRe01COR
+Dt6k1WQyVtFW+QOlyY8F9LPjSKkAevYm9FUi2fORwkneXTLkdrug1TiubKQWG5R1eV8AlxXu1uv18r64
+LBfLoqmvlG9uvwcAghhcpCgDAAA=
 `,
 	},
 
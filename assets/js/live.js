@@ -0,0 +1,71 @@
+// Keeps the vote count and standings on the page current
+// Elements with a data-live attribute are filled in from the events sent
+// by /admin/events:
+//   data-live="votes"    The number of votes
+//   data-live="teams"    The number of teams
+//   data-live="tally"    The name of the tally method
+//   data-live="results"  The standings, rebuilt on every event
+//   data-live-mode="1"   Only shown while the public mode matches
+(function() {
+  if(!window.EventSource) {
+    return;
+  }
+  var src = new EventSource('/admin/events');
+  src.onmessage = function(evt) {
+    var status;
+    try {
+      status = JSON.parse(evt.data);
+    } catch(e) {
+      return;
+    }
+    setLiveText('votes', status.vote_count);
+    setLiveText('teams', status.team_count);
+    setLiveText('tally', status.tally_method);
+    var modeEls = document.querySelectorAll('[data-live-mode]');
+    for(var i = 0; i < modeEls.length; i++) {
+      if(modeEls[i].getAttribute('data-live-mode') == status.mode) {
+        modeEls[i].classList.remove('hidden');
+      } else {
+        modeEls[i].classList.add('hidden');
+      }
+    }
+    var resEls = document.querySelectorAll('[data-live="results"]');
+    for(var i = 0; i < resEls.length; i++) {
+      buildResults(resEls[i], status.results || []);
+    }
+  }
+})()
+
+function setLiveText(name, value) {
+  var els = document.querySelectorAll('[data-live="'+name+'"]');
+  for(var i = 0; i < els.length; i++) {
+    els[i].innerText = value;
+  }
+}
+
+// buildResults replaces the contents of el with one row per team
+// If el has data-live-games, the team's game name is shown as well
+function buildResults(el, results) {
+  var showGames = el.hasAttribute('data-live-games');
+  while(el.firstChild) {
+    el.removeChild(el.firstChild);
+  }
+  for(var i = 0; i < results.length; i++) {
+    for(var j = 0; j < results[i].teams.length; j++) {
+      var tm = results[i].teams[j];
+      var row = document.createElement('div');
+      row.className = 'live-result live-rank-'+results[i].rank;
+      var txt = results[i].rank+': '+tm.name;
+      if(showGames && tm.game_name) {
+        txt += ' - '+tm.game_name;
+      }
+      row.appendChild(document.createTextNode(txt));
+      if(results[i].tie_break) {
+        var tb = document.createElement('em');
+        tb.innerText = ' (by '+results[i].tie_break+')';
+        row.appendChild(tb);
+      }
+      el.appendChild(row);
+    }
+  }
+}
@@ -1,16 +1,18 @@
 module github.com/br0xen/ictgj-voting
 
 require (
-	github.com/boltdb/bolt v1.3.1 // indirect
+	github.com/boltdb/bolt v1.3.1
 	github.com/br0xen/boltease v0.0.0-20170907120147-8d9019e01b5d
 	github.com/gorilla/handlers v1.4.0
 	github.com/gorilla/mux v1.6.2
+	github.com/gorilla/securecookie v1.1.1
 	github.com/gorilla/sessions v1.1.2
 	github.com/justinas/alice v0.0.0-20171023064455-03f45bd4b7da
 	github.com/nfnt/resize v0.0.0-20180221191011-83c6a9932646
 	github.com/pborman/uuid v1.2.0
-	golang.org/x/crypto v0.0.0-20180927165925-5295e8364332
-	golang.org/x/sys v0.0.0-20180928133829-e4b3c5e90611 // indirect
+	github.com/skip2/go-qrcode v0.0.0-20200617195104-da1b6568686e
+	golang.org/x/crypto v0.23.0
+	golang.org/x/image v0.18.0
 )
 
 go 1.13
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+)
+
+func initJudgeRequest(w http.ResponseWriter, req *http.Request) (*pageData, string) {
+	p := InitPageData(w, req)
+	p.Stylesheets = append(p.Stylesheets, "/assets/css/admin.css")
+	judge, _ := p.session.getStringValue("judge_email")
+	if !m.isValidJudgeEmail(judge) {
+		judge = ""
+	}
+	return p, judge
+}
+
+// handleJudgeDoLogin
+// Verify the provided judge credentials and redirect back to /judge
+func handleJudgeDoLogin(w http.ResponseWriter, req *http.Request) {
+	page, _ := initJudgeRequest(w, req)
+	email := req.FormValue("email")
+	password := req.FormValue("password")
+	if err := doJudgeLogin(email, password); err != nil {
+		page.session.setFlashMessage("Invalid Login", "error")
+	} else {
+		page.session.setStringValue("judge_email", email)
+	}
+	redirect("/judge", w, req)
+}
+
+// doJudgeLogin attempts to log in a judge with the given email/password
+// If it can't, it returns an error
+func doJudgeLogin(email, password string) error {
+	if strings.TrimSpace(email) != "" && strings.TrimSpace(password) != "" {
+		return m.checkJudgeCredentials(email, password)
+	}
+	return errors.New("Invalid Credentials")
+}
+
+// handleJudgeDoLogout
+// Remove the judge from the session
+func handleJudgeDoLogout(w http.ResponseWriter, req *http.Request) {
+	page, _ := initJudgeRequest(w, req)
+	page.session.setStringValue("judge_email", "")
+	page.session.setFlashMessage("Logged Out", "success")
+	redirect("/judge", w, req)
+}
+
+// Main judge handler, shows the games to score or the scoring form for one
+func handleJudge(w http.ResponseWriter, req *http.Request) {
+	page, judge := initJudgeRequest(w, req)
+	vars := mux.Vars(req)
+	if judge == "" {
+		page.SubTitle = "Judge Login"
+		page.show("judge-login.html", w)
+		return
+	}
+	tmId := vars["teamid"]
+	if tmId == "" {
+		type judgeGame struct {
+			Team   Team
+			Scored bool
+		}
+		type judgePageData struct {
+			Judge    string
+			Criteria []string
+			Games    []judgeGame
+		}
+		jpd := new(judgePageData)
+		jpd.Judge = judge
+		jpd.Criteria = m.jam.JudgeCriteria
+		for _, tm := range m.jam.Teams {
+			_, err := m.jam.GetJudgeScore(judge, tm.UUID)
+			jpd.Games = append(jpd.Games, judgeGame{Team: tm, Scored: err == nil})
+		}
+		page.SubTitle = "Judging"
+		page.TemplateData = jpd
+		page.show("judge-main.html", w)
+		return
+	}
+
+	tm, err := m.jam.GetTeamById(tmId)
+	if err != nil {
+		page.session.setFlashMessage("Couldn't find the requested game", "error")
+		redirect("/judge", w, req)
+		return
+	}
+	switch vars["function"] {
+	case "save":
+		js, err := NewJudgeScore(judge, tm.UUID)
+		if err == nil {
+			for i, c := range m.jam.JudgeCriteria {
+				if sc, err := strconv.Atoi(req.FormValue("score-" + strconv.Itoa(i))); err == nil {
+					js.Scores[c] = sc
+				}
+			}
+			err = m.jam.SetJudgeScore(js)
+		}
+		if err != nil {
+			page.session.setFlashMessage("Error saving scores: "+err.Error(), "error")
+			redirect("/judge/"+tm.UUID, w, req)
+			return
+		}
+		page.session.setFlashMessage("Scores saved for "+tm.Game.Name, "success")
+		redirect("/judge", w, req)
+	default:
+		type scoreCriterion struct {
+			Index int
+			Name  string
+			Score int
+		}
+		type scorePageData struct {
+			Team     *Team
+			Criteria []scoreCriterion
+			Min      int
+			Max      int
+		}
+		spd := new(scorePageData)
+		spd.Team = tm
+		spd.Min, spd.Max = JudgeScoreMin, JudgeScoreMax
+		js, _ := m.jam.GetJudgeScore(judge, tm.UUID)
+		for i, c := range m.jam.JudgeCriteria {
+			sc := scoreCriterion{Index: i, Name: c}
+			if js != nil {
+				sc.Score = js.Scores[c]
+			}
+			spd.Criteria = append(spd.Criteria, sc)
+		}
+		page.SubTitle = "Score " + tm.Game.Name
+		page.TemplateData = spd
+		page.show("judge-score.html", w)
+	}
+}
@@ -13,7 +13,6 @@ import (
 	"strconv"
 	"strings"
 	"syscall"
-	"time"
 
 	"golang.org/x/crypto/ssh/terminal"
 
@@ -78,19 +77,19 @@ func main() {
 	if err = m.site.SaveToDB(); err != nil {
 		errorExit("Unable to save site config to DB: " + err.Error())
 	}
-
-	// Save changes to the DB every 5 minutes
-	go func() {
-		for {
-			time.Sleep(5 * time.Minute)
-			m.saveChanges()
-		}
-	}()
+	fmt.Println("Restored public mode: " + siteModeName(m.site.GetPublicMode()) +
+		", voting terminals: " + authModeName(m.site.GetAuthMode()))
 
 	initialize()
 
+	// Open and close voting on the jam's schedule
+	go m.runScheduler()
+	// Back up the DBs every so often
+	go m.runBackups()
+
 	// We should have a session secret by now, initialize the store
 	sessionStore = sessions.NewCookieStore([]byte(m.site.sessionSecret))
+	deviceCookie = newDeviceCookie(m.site.sessionSecret)
 
 	r = mux.NewRouter()
 	r.StrictSlash(true)
@@ -100,8 +99,17 @@ func main() {
 	}
 	r.PathPrefix("/assets/").Handler(http.FileServer(FS(m.site.DevMode)))
 
+	// The event stream stays open, so it only locks the model when it needs to
+	r.HandleFunc("/admin/events", handleAdminEvents)
+	// Screenshots are streamed from disk, so they only lock it to look the file up
+	r.HandleFunc("/image/{teamid}/{imageid}", handleImageRequest)
+	r.HandleFunc("/thumbnail/{teamid}/{imageid}", handleThumbnailRequest)
+	r.HandleFunc("/jams/{id}/image/{teamid}/{imageid}", handleArchivedImageRequest)
+	r.HandleFunc("/jams/{id}/thumbnail/{teamid}/{imageid}", handleArchivedThumbnailRequest)
+
 	// Admin Subrouter
 	admin := r.PathPrefix("/admin").Subrouter()
+	admin.Use(lockHandler)
 	admin.HandleFunc("/", handleAdmin)
 	admin.HandleFunc("/dologin", handleAdminDoLogin)
 	admin.HandleFunc("/dologout", handleAdminDoLogout)
@@ -110,18 +118,56 @@ func main() {
 	admin.HandleFunc("/{category}/{id}/{function}", handleAdmin)
 	admin.HandleFunc("/{category}/{id}/{function}/{subid}", handleAdmin)
 
+	// API Subrouter
+	// apiMethods locks the model itself, so that unknown paths don't wait on it
+	api := r.PathPrefix("/api").Subrouter()
+	v1 := api.PathPrefix("/v1").Subrouter()
+	v1.Handle("/jam", apiGet(handleApiJam))
+	v1.Handle("/teams", apiMethods{
+		"GET":  handleApiTeams,
+		"POST": handleApiAddTeam,
+	})
+	v1.Handle("/teams/{id}", apiMethods{
+		"GET":    handleApiTeam,
+		"PUT":    handleApiUpdateTeam,
+		"DELETE": handleApiDeleteTeam,
+	})
+	v1.Handle("/teams/{id}/{function}", apiMethods{
+		"GET":  handleApiTeam,
+		"PUT":  handleApiUpdateTeamResource,
+		"POST": handleApiAddTeamResource,
+	})
+	v1.Handle("/teams/{id}/{function}/{subid}", apiMethods{
+		"DELETE": handleApiDeleteTeamResource,
+	})
+	v1.Handle("/games", apiGet(handleApiGames))
+	v1.Handle("/votes", apiGet(handleApiVotes))
+	v1.Handle("/results", apiGet(handleApiResults))
+	v1.Handle("/archive", apiGet(handleApiArchive))
+	v1.Handle("/archive/{id}", apiGet(handleApiArchivedJam))
+	api.PathPrefix("/").HandlerFunc(handleApiNotFound)
+
+	// Judge Subrouter
+	judge := r.PathPrefix("/judge").Subrouter()
+	judge.Use(lockHandler)
+	judge.HandleFunc("/", handleJudge)
+	judge.HandleFunc("/dologin", handleJudgeDoLogin)
+	judge.HandleFunc("/dologout", handleJudgeDoLogout)
+	judge.HandleFunc("/{teamid}", handleJudge)
+	judge.HandleFunc("/{teamid}/{function}", handleJudge)
+
 	// Public Subrouter
 	pub := r.PathPrefix("/").Subrouter()
+	pub.Use(lockHandler)
 	pub.HandleFunc("/", handleMain)
+	pub.HandleFunc("/jams", handlePublicArchive)
+	pub.HandleFunc("/jams/{id}", handlePublicArchive)
+	pub.HandleFunc("/jams/{id}/games/{teamid}", handlePublicArchive)
 	pub.HandleFunc("/{function}", handleMain)
-	pub.HandleFunc("/image/{teamid}/{imageid}", handleImageRequest)
-	pub.HandleFunc("/thumbnail/{teamid}/{imageid}", handleThumbnailRequest)
 	pub.HandleFunc("/team/{id}", handleTeamMgmtRequest)
 	pub.HandleFunc("/team/{id}/{function}", handleTeamMgmtRequest)
 	pub.HandleFunc("/team/{id}/{function}/{subid}", handleTeamMgmtRequest)
 
-	// API Subrouter
-	//api := r.PathPrefix("/api").Subtrouter()
 	http.Handle("/", r)
 
 	chain := alice.New(loggingHandler).Then(r)
@@ -133,6 +179,7 @@ func main() {
 		<-c
 		// Save the changes when the app quits
 		fmt.Println("\nFinishing up...")
+		m.mu.Lock()
 		m.saveChanges()
 		os.Exit(0)
 	}()
@@ -173,6 +220,48 @@ func loadConfig() {
 			case "-server-dir":
 				// TODO: Probably check if the given directory is valid
 				m.site.ServerDir = val
+			case "-thumbnail-sizes":
+				if sizes, err := parseThumbnailSizes(val); err != nil {
+					fmt.Println(err.Error())
+				} else {
+					m.site.ThumbnailSizes = sizes
+					fmt.Print("Set thumbnail sizes: ", sizes, "\n")
+				}
+			case "-max-upload":
+				if mb, err := strconv.Atoi(val); err != nil || mb <= 0 {
+					fmt.Print("Invalid upload size given: ", val, " (Must be a number of MB)\n")
+				} else {
+					m.site.MaxUploadSize = mb << 20
+				}
+			case "-max-image-size":
+				if px, err := strconv.Atoi(val); err != nil || px <= 0 {
+					fmt.Print("Invalid image size given: ", val, " (Must be a number of pixels)\n")
+				} else {
+					m.site.MaxImageSize = px
+				}
+			case "-backup-interval":
+				if mins, err := strconv.Atoi(val); err != nil || mins < 0 {
+					fmt.Print("Invalid backup interval given: ", val, " (Must be a number of minutes, 0 turns backups off)\n")
+				} else {
+					m.site.BackupInterval = mins
+				}
+			case "-backup-keep":
+				if keep, err := strconv.Atoi(val); err != nil || keep <= 0 {
+					fmt.Print("Invalid number of backups given: ", val, " (Must be at least 1)\n")
+				} else {
+					m.site.BackupKeep = keep
+				}
+			case "-trusted-proxies":
+				// An empty list stops trusting any proxies
+				if nets, err := parseTrustedProxies(val); err != nil {
+					fmt.Println(err.Error())
+				} else {
+					m.site.TrustedProxies = nets
+					fmt.Print("Set trusted proxies: ", trustedProxiesString(nets), "\n")
+				}
+			case "-restore-backup":
+				restoreBackup(val)
+				done()
 			case "-help", "-h", "-?":
 				printHelp()
 				done()
@@ -181,6 +270,12 @@ func loadConfig() {
 			case "-reset-defaults":
 				resetToDefaults()
 				done()
+			case "-export":
+				exportJam(val)
+				done()
+			case "-import":
+				importJam(val)
+				done()
 			}
 		}
 	}
@@ -242,6 +337,17 @@ func loggingHandler(h http.Handler) http.Handler {
 	return handlers.LoggingHandler(os.Stdout, h)
 }
 
+// lockHandler holds the model's lock for the whole request
+// Handlers change the model all over the place, so one request at a time
+// gets it, that way two kiosks voting at once can't clobber each other.
+func lockHandler(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		h.ServeHTTP(w, req)
+	})
+}
+
 func InitPageData(w http.ResponseWriter, req *http.Request) *pageData {
 	if m.site.DevMode {
 		w.Header().Set("Cache-Control", "no-cache")
@@ -263,6 +369,12 @@ func InitPageData(w http.ResponseWriter, req *http.Request) *pageData {
 	userEmail, _ := p.session.getStringValue("email")
 	// With a valid account
 	p.LoggedIn = m.isValidUserEmail(userEmail)
+	// Or with an admin API token
+	if !p.LoggedIn {
+		if tkn, err := m.checkToken(getBearerToken(req)); err == nil {
+			p.LoggedIn = tkn.HasScope(ScopeAdmin)
+		}
+	}
 
 	p.Site = m.site
 	p.SubTitle = "GameJam Voting"
@@ -292,7 +404,9 @@ func InitPageData(w http.ResponseWriter, req *http.Request) *pageData {
 		p.Menu = append(p.Menu, menuItem{"Votes", "/admin/votes", "zmdi-assignment-check"})
 		p.Menu = append(p.Menu, menuItem{"Archive", "/admin/archive", "zmdi-archive"})
 		p.Menu = append(p.Menu, menuItem{"Clients", "/admin/clients", "zmdi-devices"})
+		p.Menu = append(p.Menu, menuItem{"Backups", "/admin/backups", "zmdi-time-restore"})
 
+		p.BottomMenu = append(p.BottomMenu, menuItem{"Judges", "/admin/judges", "zmdi-star"})
 		p.BottomMenu = append(p.BottomMenu, menuItem{"Users", "/admin/users", "zmdi-accounts"})
 		p.BottomMenu = append(p.BottomMenu, menuItem{"Logout", "/admin/dologout", "zmdi-eject"})
 	} else {
@@ -305,6 +419,11 @@ func InitPageData(w http.ResponseWriter, req *http.Request) *pageData {
 	if cl, err = m.GetClient(p.ClientId); err != nil {
 		// A new client
 		cl = NewClient(p.ClientId)
+	} else if ip := requestIp(req); cl.IP != ip {
+		cl.IP = ip
+		if err = m.UpdateClient(cl); err != nil {
+			fmt.Println("Error saving client IP: " + err.Error())
+		}
 	}
 	p.ClientIsAuth = cl.Auth
 	p.ClientIsServer = clientIsServer(req)
@@ -359,6 +478,9 @@ func resetToDefaults() {
 	conf, _ := reader.ReadString('\n')
 	conf = strings.ToUpper(strings.TrimSpace(conf))
 	if strings.HasPrefix(conf, "Y") {
+		if err := m.backupBefore("reset-defaults"); err != nil {
+			errorExit("Error resetting to defaults: " + err.Error())
+		}
 		if err := def.SaveToDB(); err != nil {
 			errorExit("Error resetting to defaults: " + err.Error())
 		}
@@ -366,6 +488,66 @@ func resetToDefaults() {
 	}
 }
 
+// exportJam writes the jam with the given id to a bundle in the working directory
+func exportJam(id string) {
+	a, err := m.getBundleJam(id)
+	if err != nil {
+		fmt.Println(err.Error())
+		fmt.Println("Archived jams:")
+		for _, v := range m.archive.Jams {
+			fmt.Println("  " + v.UUID + "  " + v.Name)
+		}
+		errorExit("Use 'current' to export the current jam")
+	}
+	fn := a.bundleFilename()
+	f, err := os.Create(fn)
+	if err != nil {
+		errorExit("Error creating bundle: " + err.Error())
+	}
+	if err = a.WriteBundle(f); err == nil {
+		err = f.Close()
+	}
+	if err != nil {
+		f.Close()
+		os.Remove(fn)
+		errorExit("Error exporting jam: " + err.Error())
+	}
+	fmt.Println("Exported " + a.Name + " to " + fn)
+}
+
+// restoreBackup replaces the DBs with the backup with the given name
+func restoreBackup(name string) {
+	if err := m.RestoreBackup(name); err != nil {
+		fmt.Println(err.Error())
+		if bks, _ := ListBackups(); len(bks) > 0 {
+			fmt.Println("Backups:")
+			for _, bk := range bks {
+				fmt.Println("  " + bk.Name)
+			}
+		}
+		errorExit("Unable to restore backup")
+	}
+	fmt.Println("Restored backup " + name)
+}
+
+// importJam adds the jam in the bundle at fn to the archive
+func importJam(fn string) {
+	f, err := os.Open(fn)
+	if err != nil {
+		errorExit("Error opening bundle: " + err.Error())
+	}
+	defer f.Close()
+	st, err := f.Stat()
+	if err != nil {
+		errorExit("Error opening bundle: " + err.Error())
+	}
+	a, err := m.ImportBundle(f, st.Size())
+	if err != nil {
+		errorExit("Error importing jam: " + err.Error())
+	}
+	fmt.Println("Imported " + a.Name + " (" + a.UUID + ") into the archive")
+}
+
 func printHelp() {
 	help := []string{
 		"Game Jam Voting Help",
@@ -379,7 +561,24 @@ func printHelp() {
 		"                           operating in 'development' mode (-dev)",
 		"  -title=<title>           Set the site title",
 		"  -current-jam=<name>      Change the name of the current jam",
+		"  -thumbnail-sizes=<list>  Set the widths of screenshot thumbnails (default 200,600)",
+		"  -max-upload=<MB>         Set the largest screenshot file accepted (default 10)",
+		"  -max-image-size=<px>     Set the most pixels wide or tall a screenshot can be",
+		"                           (default 8192)",
+		"  -backup-interval=<min>   Set how often the database is backed up (default 60,",
+		"                           0 turns scheduled backups off)",
+		"  -backup-keep=<n>         Set how many backups are kept (default 24)",
+		"  -trusted-proxies=<list>  Set the proxies (IPs or CIDR ranges) whose",
+		"                           X-Forwarded-For header is trusted for the client's",
+		"                           IP, an empty list trusts none",
+		"  -restore-backup=<name>   Replace the database with a backup from data/backups",
+		"                           (stop the server first)",
 		"  -reset-defaults          Reset all configuration options to defaults",
+		"  -export=<jam id>         Export a jam as a bundle (a zip file) in the current",
+		"                           directory, the id is an archived jam's UUID, or",
+		"                           'current' for the current jam",
+		"  -import=<file>           Import a jam from a bundle into the archive, from",
+		"                           where it can be restored as the current jam",
 		"",
 	}
 	for _, v := range help {
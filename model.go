@@ -4,22 +4,28 @@ import (
 	"errors"
 	"fmt"
 	"os"
-
-	"github.com/br0xen/boltease"
+	"sync"
 )
 
 // model stores the current jam in memory, and has the ability to access archived dbs
+// Anything that uses the model has to hold mu, HTTP requests get it from
+// lockHandler (or apiMethods for the API). Even reads need it to themselves,
+// boltease isn't safe to use from more than one goroutine at a time.
 type model struct {
-	bolt       *boltease.DB
-	dbOpened   int
-	dbFileName string
+	mu sync.Mutex
+
+	storage  Storage    // Where the main DB and the archived jams' DBs are kept
+	db       Store      // The main DB, while it's open
+	dbMu     sync.Mutex // Guards dbOpened and opening/closing db
+	dbOpened int
 
-	site    *siteData // Configuration data for the site
-	jam     *Gamejam  // The currently active gamejam
-	clients []Client  // Web clients that have connected to the server
-	archive *Archive  // The archive of past game jams
+	site     *siteData     // Configuration data for the site
+	jam      *Gamejam      // The currently active gamejam
+	clients  []Client      // Web clients that have connected to the server
+	pairings []PairingCode // Codes that unauthenticated clients are showing
+	archive  *Archive      // The archive of past game jams
 
-	clientsUpdated bool
+	events *eventBroker // Pushes changes out to live pages
 }
 
 // Update Flags: Which parts of the model need to be updated
@@ -28,15 +34,26 @@ const (
 	UpdateJamData
 )
 
+// NewModel returns a model with its DBs in bolt files in DataDir
 func NewModel() (*model, error) {
+	// make sure the data directory exists
+	if err := os.MkdirAll(DataDir, os.ModePerm); err != nil {
+		return nil, errors.New("Unable to create Data Directory: " + err.Error())
+	}
+	return NewModelWithStorage(NewBoltStorage(DataDir))
+}
+
+// NewModelWithStorage returns a model with its DBs in the given Storage
+func NewModelWithStorage(st Storage) (*model, error) {
 	var err error
 	m := new(model)
+	m.events = newEventBroker()
+	m.storage = st
 
-	// make sure the data directory exists
-	if err = os.MkdirAll(DataDir, os.ModePerm); err != nil {
-		return nil, errors.New("Unable to create Data Directory: " + err.Error())
+	// Bring the DB up to date with this version's layout
+	if err = m.migrateDB(m.storage.Exists(DbName)); err != nil {
+		return nil, err
 	}
-	m.dbFileName = DataDir + "/" + DbName
 	if err = m.openDB(); err != nil {
 		return nil, errors.New("Unable to open DB: " + err.Error())
 	}
@@ -71,22 +88,34 @@ func NewModel() (*model, error) {
 	return m, nil
 }
 
+// openDB opens the database if it isn't already open
+// Every successful call has to be matched with a call to closeDB
 func (m *model) openDB() error {
+	m.dbMu.Lock()
+	defer m.dbMu.Unlock()
 	m.dbOpened += 1
 	if m.dbOpened == 1 {
 		var err error
-		m.bolt, err = boltease.Create(m.dbFileName, 0600, nil)
+		m.db, err = m.storage.Open(DbName)
 		if err != nil {
+			// Callers don't closeDB after a failed open
+			m.dbOpened -= 1
 			return err
 		}
 	}
 	return nil
 }
 
+// closeDB closes the database once every openDB has been matched
 func (m *model) closeDB() error {
+	m.dbMu.Lock()
+	defer m.dbMu.Unlock()
+	if m.dbOpened == 0 {
+		return errors.New("Database isn't open")
+	}
 	m.dbOpened -= 1
 	if m.dbOpened == 0 {
-		return m.bolt.CloseDB()
+		return m.db.CloseDB()
 	}
 	return nil
 }
@@ -99,26 +128,36 @@ func (m *model) initDB() error {
 	defer m.closeDB()
 
 	// Create the path to the bucket to store admin users
-	if err = m.bolt.MkBucketPath([]string{"users"}); err != nil {
+	if err = m.db.MkBucketPath([]string{"users"}); err != nil {
+		return err
+	}
+	// Create the path to the bucket to store API tokens
+	if err = m.db.MkBucketPath([]string{"tokens"}); err != nil {
+		return err
+	}
+	// Create the path to the bucket to store judges
+	if err = m.db.MkBucketPath([]string{"judges"}); err != nil {
 		return err
 	}
 	// Create the path to the bucket to store the web clients
-	if err = m.bolt.MkBucketPath([]string{"clients"}); err != nil {
+	if err = m.db.MkBucketPath([]string{"clients"}); err != nil {
 		return err
 	}
 	// Create the path to the bucket to store the current jam & teams
-	if err = m.bolt.MkBucketPath([]string{"jam", "teams"}); err != nil {
+	if err = m.db.MkBucketPath([]string{"jam", "teams"}); err != nil {
 		return err
 	}
 	// Create the path to the bucket to store the list of archived jams
-	if err = m.bolt.MkBucketPath([]string{"archive"}); err != nil {
+	if err = m.db.MkBucketPath([]string{"archive"}); err != nil {
 		return err
 	}
 	// Create the path to the bucket to store site config data
-	return m.bolt.MkBucketPath([]string{"site"})
+	return m.db.MkBucketPath([]string{"site"})
 }
 
-// saveChanges saves any parts of the model that have been flagged as changed to the database
+// saveChanges saves the whole model to the database
+// Changes are written as they're made, so this is only a final sync when the
+// app shuts down (and when a jam is archived).
 func (m *model) saveChanges() error {
 	var err error
 	if err = m.openDB(); err != nil {
@@ -139,7 +178,6 @@ func (m *model) saveChanges() error {
 	if err = m.SaveAllClients(); err != nil {
 		return err
 	}
-	m.clientsUpdated = false
 	if err = m.SaveArchive(); err != nil {
 		return err
 	}
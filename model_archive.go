@@ -3,15 +3,15 @@ package main
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"strconv"
+	"strings"
 	"time"
 
-	"github.com/br0xen/boltease"
 	"github.com/pborman/uuid"
 )
 
-// Archived GameJams are in their own DB files in the data directory
-// named `gamejam_<uuid>.db`
+// Archived GameJams are in their own DBs, named `gamejam_<uuid>.db`
 type Archive struct {
 	Jams []ArchivedGamejam
 
@@ -33,16 +33,18 @@ func (m *model) LoadArchive() (*Archive, error) {
 	defer m.closeDB()
 
 	arc := NewArchive(m)
-	vals, err := m.bolt.GetValueList(arc.mPath)
+	vals, err := m.db.GetValueList(arc.mPath)
 	if err != nil {
 		// There apparently aren't any archived Jams
 		return arc, nil
 	}
 	for _, v := range vals {
-		arcgj, err := NewArchivedGamejam(v)
-		if err == nil {
-			arc.Jams = append(arc.Jams, *arcgj)
+		arcgj, err := NewArchivedGamejam(m.storage, v)
+		if err != nil {
+			fmt.Println("Unable to load archived jam " + v + ": " + err.Error())
+			continue
 		}
+		arc.Jams = append(arc.Jams, *arcgj)
 	}
 
 	return arc, nil
@@ -57,18 +59,59 @@ func (m *model) SaveArchive() error {
 	fmt.Println("Saving Archive")
 	for k, v := range m.archive.Jams {
 		fmt.Printf("> %d. %s\n", k, v.UUID)
-		m.bolt.SetValue([]string{"archive"}, strconv.Itoa(k), v.UUID)
+		if err = m.db.SetValue([]string{"archive"}, strconv.Itoa(k), v.UUID); err != nil {
+			return err
+		}
+	}
+	// Remove any entries past the end, from when the list was longer
+	var keys []string
+	if keys, err = m.db.GetKeyList([]string{"archive"}); err != nil {
+		return nil
+	}
+	for _, k := range keys {
+		if idx, err := strconv.Atoi(k); err != nil || idx >= len(m.archive.Jams) {
+			if err = m.db.DeletePair([]string{"archive"}, k); err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
 
 func (m *model) ArchiveCurrentJam() error {
+	if err := m.backupBefore("archive"); err != nil {
+		return err
+	}
+	gj := m.archivedCurrentJam()
+	err := gj.Save(m.storage)
+	if err != nil {
+		return err
+	}
+	m.archive.Jams = append(m.archive.Jams, *gj)
+	// Now we need to clear the current jam
+	if err := m.clearCurrentJam(); err != nil {
+		return err
+	}
+	return m.saveChanges()
+}
+
+// archivedCurrentJam returns the current jam as it would be archived, with
+// its results as they stand right now
+func (m *model) archivedCurrentJam() *ArchivedGamejam {
 	gj := new(ArchivedGamejam)
 	if m.jam.UUID == "" {
 		m.jam.UUID = uuid.New()
 	}
 	gj.UUID = m.jam.UUID
 	gj.Name = m.jam.Name
+	gj.Date = m.jam.Date
+	if gj.Date.IsZero() {
+		// The jam's date isn't set anywhere, so go with when it was archived
+		gj.Date = time.Now().Truncate(time.Second)
+	}
+	gj.TallyMethod = m.jam.TallyMethod
+	gj.TieBreaks = m.jam.TieBreaks
+	gj.CoinFlips = m.jam.CoinFlips
 	// We save the teams to the archive in their ranked order
 	for k := range m.jam.Teams {
 		gj.Teams = append(gj.Teams, m.jam.Teams[k])
@@ -76,19 +119,23 @@ func (m *model) ArchiveCurrentJam() error {
 	for k := range m.jam.Votes {
 		gj.Votes = append(gj.Votes, m.jam.Votes[k])
 	}
-	rankings := getCondorcetResult()
-	for _, v := range rankings {
-		for _, tv := range v.Teams {
-			gj.Rankings = append(gj.Rankings, tv.UUID)
-		}
+	gj.Rankings, gj.RankPlaces, gj.RankTieBreaks = flattenRankings(m.jam.GetResults())
+	gj.JudgeCriteria = m.jam.JudgeCriteria
+	gj.JudgeWeight = m.jam.JudgeWeight
+	gj.JudgeScores = m.jam.JudgeScores
+	if len(m.jam.JudgeCriteria) > 0 {
+		gj.CombinedStandings = m.jam.GetCombinedStandings()
 	}
-
-	err := gj.Save()
-	if err != nil {
-		return err
+	for i := range m.jam.Categories {
+		cat := ArchivedCategory{Category: m.jam.Categories[i]}
+		cat.Rankings, cat.RankPlaces, cat.RankTieBreaks = flattenRankings(m.jam.GetCategoryResults(&m.jam.Categories[i]))
+		gj.Categories = append(gj.Categories, cat)
 	}
-	m.archive.Jams = append(m.archive.Jams, *gj)
-	// Now we need to clear the current jam
+	return gj
+}
+
+// clearCurrentJam replaces the current jam with a new, empty one
+func (m *model) clearCurrentJam() error {
 	m.jam = NewGamejam(m)
 
 	// Delete the Teams/Votes buckets from the jam
@@ -96,41 +143,157 @@ func (m *model) ArchiveCurrentJam() error {
 		return err
 	}
 	defer m.closeDB()
-	if err := m.bolt.DeleteBucket([]string{"jam"}, "teams"); err != nil {
-		return err
+	for _, bkt := range []string{"teams", "votes", "categories", "judgescores"} {
+		if err := m.db.DeleteBucket([]string{"jam"}, bkt); err != nil {
+			return err
+		}
 	}
-	if err := m.bolt.DeleteBucket([]string{"jam"}, "votes"); err != nil {
-		return err
+	return nil
+}
+
+// RestoreArchivedJam makes the archived jam with the given UUID the current jam
+// Unless the current jam is empty, it's archived first, so nothing is lost
+// if the wrong jam gets restored
+func (m *model) RestoreArchivedJam(id string) error {
+	var arc *ArchivedGamejam
+	for i := range m.archive.Jams {
+		if m.archive.Jams[i].UUID == id {
+			arc = &m.archive.Jams[i]
+			break
+		}
 	}
-	return m.saveChanges()
+	if arc == nil {
+		return errors.New("Couldn't find archived jam: " + id)
+	}
+	restored := arc.toGamejam(m)
+
+	if !m.jam.isBlank() {
+		if err := m.ArchiveCurrentJam(); err != nil {
+			return errors.New("Error archiving the current jam: " + err.Error())
+		}
+	} else {
+		// Archiving takes a backup, clearing doesn't
+		if err := m.backupBefore("restore-jam"); err != nil {
+			return err
+		}
+		if err := m.clearCurrentJam(); err != nil {
+			return errors.New("Error clearing the current jam: " + err.Error())
+		}
+	}
+
+	m.jam = restored
+	if err := m.jam.SaveToDB(); err != nil {
+		return errors.New("Error saving the restored jam: " + err.Error())
+	}
+	// It's the current jam again, so it comes out of the archive
+	// The DB file is left alone, it's replaced if the jam is archived again
+	for i := range m.archive.Jams {
+		if m.archive.Jams[i].UUID == id {
+			m.archive.Jams = append(m.archive.Jams[:i], m.archive.Jams[i+1:]...)
+			break
+		}
+	}
+	if err := m.SaveArchive(); err != nil {
+		return errors.New("Error saving the archive: " + err.Error())
+	}
+	m.publishEvent(EventTeam)
+	return nil
+}
+
+// isBlank is whether the jam is still the way NewGamejam left it, so nothing
+// would be lost by clearing it
+func (gj *Gamejam) isBlank() bool {
+	if len(gj.Teams) > 0 || len(gj.Votes) > 0 || len(gj.Categories) > 0 {
+		return false
+	}
+	if len(gj.JudgeCriteria) > 0 || len(gj.JudgeScores) > 0 || len(gj.CoinFlips) > 0 {
+		return false
+	}
+	if !gj.VotingOpens.IsZero() || !gj.VotingCloses.IsZero() {
+		return false
+	}
+	def := NewGamejam(gj.m)
+	if gj.TallyMethod != def.TallyMethod || gj.JudgeWeight != def.JudgeWeight {
+		return false
+	}
+	return strings.Join(gj.TieBreaks, ",") == strings.Join(def.TieBreaks, ",")
+}
+
+// toGamejam builds a current jam out of the archived one
+func (a *ArchivedGamejam) toGamejam(m *model) *Gamejam {
+	gj := NewGamejam(m)
+	gj.UUID = a.UUID
+	gj.Name = a.Name
+	gj.Date = a.Date
+	gj.TallyMethod = a.TallyMethod
+	gj.TieBreaks = append([]string{}, a.TieBreaks...)
+	gj.CoinFlips = append([]string{}, a.CoinFlips...)
+	for _, cat := range a.Categories {
+		gj.Categories = append(gj.Categories, cat.Category)
+	}
+	gj.JudgeCriteria = append([]string{}, a.JudgeCriteria...)
+	gj.JudgeWeight = a.JudgeWeight
+	gj.JudgeScores = append([]JudgeScore{}, a.JudgeScores...)
+	gj.Teams = append([]Team{}, a.Teams...)
+	gj.Votes = append([]Vote{}, a.Votes...)
+	return gj
 }
 
 type ArchivedGamejam struct {
-	UUID     string
-	Name     string
-	Date     time.Time
-	Rankings []string
-	Teams    []Team
-	Votes    []Vote
+	UUID        string
+	Name        string
+	Date        time.Time
+	TallyMethod string   // Key of the TallyMethod the Rankings were computed with
+	TieBreaks   []string // Keys of the TieBreakers that were used to settle ties
+	CoinFlips   []string // Team UUIDs in the order recorded coin flips placed them
+	Rankings    []string
+	// RankPlaces and RankTieBreaks line up with Rankings: the place each team
+	// finished in and the name of the tie-break that put it there, if any
+	RankPlaces    []int
+	RankTieBreaks []string
+	Categories    []ArchivedCategory
+	// The judging setup and the standings it produced when the jam was archived
+	JudgeCriteria     []string
+	JudgeWeight       int
+	JudgeScores       []JudgeScore
+	CombinedStandings []CombinedStanding
+	Teams             []Team
+	Votes             []Vote
 }
 
-func NewArchivedGamejam(uuid string) (*ArchivedGamejam, error) {
+// NewArchivedGamejam loads the archived jam with the given UUID from st
+func NewArchivedGamejam(st Storage, uuid string) (*ArchivedGamejam, error) {
 	gj := new(ArchivedGamejam)
 	gj.UUID = uuid
-	bolt, err := boltease.Create(DataDir+"/gamejam_"+uuid+".db", 0600, nil)
-	defer bolt.CloseDB()
+	bolt, err := st.Open(archiveDBName(uuid))
 	if err != nil {
 		return nil, err
 	}
+	defer bolt.CloseDB()
 	gj.Name, err = bolt.GetValue([]string{"jam"}, "name")
 	if err != nil {
 		return nil, err
 	}
-	// Now load in all of the teams
-	var tmUUIDs []string
-	if tmUUIDs, err = bolt.GetBucketList([]string{"jam", "teams"}); err != nil {
+	if err = runMigrations(bolt, archiveDBName(uuid), true); err != nil {
 		return nil, err
 	}
+	if gj.TallyMethod, err = bolt.GetValue([]string{"jam"}, "tallymethod"); err != nil || gj.TallyMethod == "" {
+		// Jams archived before tally methods were selectable used Condorcet
+		gj.TallyMethod = TallyCondorcet
+	}
+	if dt, _ := bolt.GetValue([]string{"jam"}, "date"); dt != "" {
+		gj.Date, _ = time.Parse(time.RFC3339, dt)
+	}
+	if tbs, _ := bolt.GetValue([]string{"jam"}, "tiebreaks"); tbs != "" {
+		gj.TieBreaks = cleanTieBreaks(strings.Split(tbs, ","))
+	}
+	if flips, _ := bolt.GetValue([]string{"jam"}, "coinflips"); flips != "" {
+		gj.CoinFlips = strings.Split(flips, ",")
+	}
+	// Now load in all of the teams
+	// A jam that was archived without any teams doesn't have a teams bucket
+	var tmUUIDs []string
+	tmUUIDs, _ = bolt.GetBucketList([]string{"jam", "teams"})
 	for _, v := range tmUUIDs {
 		tm, err := gj.LoadTeam(bolt, v)
 		if err != nil {
@@ -142,25 +305,25 @@ func NewArchivedGamejam(uuid string) (*ArchivedGamejam, error) {
 	// Now load the votes
 	gj.Votes = gj.LoadAllVotes(bolt)
 
-	// And finally, the Rankings
-	var ranks []string
-	if ranks, err = bolt.GetKeyList([]string{"jam", "rankings"}); err != nil {
-		return nil, err
-	}
-	for _, v := range ranks {
-		var tmUUID string
-		tmUUID, err = bolt.GetValue([]string{"jam", "rankings"}, v)
-		if err != nil {
-			return nil, err
-		}
-		gj.Rankings = append(gj.Rankings, tmUUID)
+	// And finally, the Rankings (if there were any votes to rank by)
+	gj.Rankings, gj.RankPlaces, gj.RankTieBreaks, _ = loadRankings(bolt, []string{"jam"})
+
+	// Along with the results for each award category
+	gj.Categories = gj.LoadAllCategories(bolt)
+
+	// And the judging
+	if criteria, _ := bolt.GetValue([]string{"jam"}, "judgecriteria"); criteria != "" {
+		gj.JudgeCriteria = cleanJudgeCriteria(strings.Split(criteria, ","))
 	}
+	gj.JudgeWeight, _ = bolt.GetInt([]string{"jam"}, "judgeweight")
+	gj.JudgeScores = gj.LoadAllJudgeScores(bolt)
+	gj.CombinedStandings = gj.LoadCombinedStandings(bolt)
 
 	// We could pull votes too... But I'm not right now.
 	return gj, nil
 }
 
-func (a *ArchivedGamejam) LoadTeam(openbolt *boltease.DB, uuid string) (*Team, error) {
+func (a *ArchivedGamejam) LoadTeam(openbolt Store, uuid string) (*Team, error) {
 	tm := NewTeam(uuid)
 	var err error
 	if tm.Name, err = openbolt.GetValue(tm.mPath, "name"); err != nil {
@@ -179,6 +342,10 @@ func (a *ArchivedGamejam) LoadTeam(openbolt *boltease.DB, uuid string) (*Team, e
 			if mbr.Name, err = openbolt.GetValue(mbr.mPath, "name"); err != nil {
 				return nil, errors.New("Error loading team member: " + err.Error())
 			}
+			mbr.SlackId, _ = openbolt.GetValue(mbr.mPath, "slackid")
+			mbr.Twitter, _ = openbolt.GetValue(mbr.mPath, "twitter")
+			mbr.Email, _ = openbolt.GetValue(mbr.mPath, "email")
+			mbr.Public, _ = openbolt.GetBool(mbr.mPath, "public")
 			tm.Members = append(tm.Members, *mbr)
 		}
 	}
@@ -194,11 +361,47 @@ func (a *ArchivedGamejam) LoadTeam(openbolt *boltease.DB, uuid string) (*Team, e
 	if tm.Game.Link, err = openbolt.GetValue(tm.Game.mPath, "link"); err != nil {
 		tm.Game.Link = ""
 	}
+	if tm.Game.Description, err = openbolt.GetValue(tm.Game.mPath, "description"); err != nil {
+		tm.Game.Description = ""
+	}
+	if tm.Game.Framework, err = openbolt.GetValue(tm.Game.mPath, "framework"); err != nil {
+		tm.Game.Framework = ""
+	}
+
+	// And its screenshots
+	var ssIds []string
+	if ssIds, err = openbolt.GetBucketList(append(tm.Game.mPath, "screenshots")); err == nil {
+		for _, v := range ssIds {
+			ss, err := NewScreenshot(uuid, v)
+			if err != nil {
+				return nil, err
+			}
+			ss.Description, _ = openbolt.GetValue(ss.mPath, "description")
+			ss.ImageHash, _ = openbolt.GetValue(ss.mPath, "imagehash")
+			ss.ThumbnailHash, _ = openbolt.GetValue(ss.mPath, "thumbnailhash")
+			if ss.ThumbnailHash == "" {
+				ss.ThumbnailHash = ss.ImageHash
+			}
+			ss.Thumbnails = make(map[int]string)
+			szs, _ := openbolt.GetKeyList(append(ss.mPath, "thumbnails"))
+			for _, sz := range szs {
+				wd, err := strconv.Atoi(sz)
+				if err != nil {
+					continue
+				}
+				if h, _ := openbolt.GetValue(append(ss.mPath, "thumbnails"), sz); h != "" {
+					ss.Thumbnails[wd] = h
+				}
+			}
+			ss.Filetype, _ = openbolt.GetValue(ss.mPath, "filetype")
+			tm.Game.Screenshots = append(tm.Game.Screenshots, *ss)
+		}
+	}
 	return tm, nil
 }
 
 // LoadAllVotes loads all votes for the jam out of the database
-func (a *ArchivedGamejam) LoadAllVotes(openbolt *boltease.DB) []Vote {
+func (a *ArchivedGamejam) LoadAllVotes(openbolt Store) []Vote {
 	var err error
 	var ret []Vote
 	votesPath := []string{"jam", "votes"}
@@ -214,7 +417,6 @@ func (a *ArchivedGamejam) LoadAllVotes(openbolt *boltease.DB) []Vote {
 			continue
 		}
 		for _, t := range times {
-			fmt.Println("Loading Vote", cId, t)
 			if vt, err := a.LoadVote(openbolt, cId, t); err == nil {
 				ret = append(ret, *vt)
 			}
@@ -224,7 +426,7 @@ func (a *ArchivedGamejam) LoadAllVotes(openbolt *boltease.DB) []Vote {
 }
 
 // Load a vote from the DB and return it
-func (a *ArchivedGamejam) LoadVote(openbolt *boltease.DB, clientId, t string) (*Vote, error) {
+func (a *ArchivedGamejam) LoadVote(openbolt Store, clientId, t string) (*Vote, error) {
 	var tm time.Time
 	var err error
 	if tm, err = time.Parse(time.RFC3339, t); err != nil {
@@ -234,20 +436,11 @@ func (a *ArchivedGamejam) LoadVote(openbolt *boltease.DB, clientId, t string) (*
 	if err != nil {
 		return nil, errors.New("Error creating vote: " + err.Error())
 	}
-	var choices []string
-	if choices, err = openbolt.GetKeyList(vt.mPath); err != nil {
+	if vt.Choices, err = loadChoices(openbolt, vt.mPath); err != nil {
 		return nil, errors.New("Error creating vote: " + err.Error())
 	}
-	for _, v := range choices {
-		ch := new(GameChoice)
-		var rank int
-		if rank, err = strconv.Atoi(v); err == nil {
-			ch.Rank = rank
-			if ch.Team, err = openbolt.GetValue(vt.mPath, v); err == nil {
-				vt.Choices = append(vt.Choices, *ch)
-			}
-		}
-	}
+	vt.CategoryChoices = loadCategoryChoices(openbolt, vt.mPath)
+	vt.sortChoices()
 	if vt.VoterStatus, err = openbolt.GetValue(vt.mPath, "voterstatus"); err != nil {
 		vt.VoterStatus = ""
 	}
@@ -257,10 +450,37 @@ func (a *ArchivedGamejam) LoadVote(openbolt *boltease.DB, clientId, t string) (*
 	return vt, nil
 }
 
-func (a *ArchivedGamejam) Save() error {
-	bolt, err := boltease.Create(DataDir+"/gamejam_"+a.UUID+".db", 0600, nil)
-	defer bolt.CloseDB()
+// Save writes the archived jam to its own DB in st
+func (a *ArchivedGamejam) Save(st Storage) error {
+	// It's written to a fresh DB that only replaces the old one once it's
+	// all there. A jam that was restored and archived again shouldn't keep
+	// anything that was removed from it in between, and a failed write
+	// shouldn't lose the archive that was already there.
+	name := archiveDBName(a.UUID)
+	tmp := name + ".tmp"
+	if err := st.Remove(tmp); err != nil {
+		return err
+	}
+	bolt, err := st.Open(tmp)
+	if err != nil {
+		return err
+	}
+	err = bolt.Update(a.write)
+	if cerr := bolt.CloseDB(); err == nil {
+		err = cerr
+	}
 	if err != nil {
+		st.Remove(tmp)
+		return err
+	}
+	return st.Rename(tmp, name)
+}
+
+// write writes the archived jam to bolt
+func (a *ArchivedGamejam) write(bolt Store) error {
+	var err error
+	// It's written in this version's layout
+	if err := setSchemaVersion(bolt, schemaVersion()); err != nil {
 		return err
 	}
 	// Gamejam info
@@ -270,6 +490,18 @@ func (a *ArchivedGamejam) Save() error {
 	if err := bolt.SetValue([]string{"jam"}, "name", a.Name); err != nil {
 		return err
 	}
+	if err := bolt.SetValue([]string{"jam"}, "date", a.Date.Format(time.RFC3339)); err != nil {
+		return err
+	}
+	if err := bolt.SetValue([]string{"jam"}, "tallymethod", a.TallyMethod); err != nil {
+		return err
+	}
+	if err := bolt.SetValue([]string{"jam"}, "tiebreaks", strings.Join(a.TieBreaks, ",")); err != nil {
+		return err
+	}
+	if err := bolt.SetValue([]string{"jam"}, "coinflips", strings.Join(a.CoinFlips, ",")); err != nil {
+		return err
+	}
 	// Teams info
 	for _, tm := range a.Teams {
 		if err := bolt.SetValue(tm.mPath, "name", tm.Name); err != nil {
@@ -288,9 +520,15 @@ func (a *ArchivedGamejam) Save() error {
 			if err = bolt.SetValue(mbr.mPath, "email", mbr.Email); err != nil {
 				return err
 			}
+			if err = bolt.SetBool(mbr.mPath, "public", mbr.Public); err != nil {
+				return err
+			}
 		}
 		// The team's game
 		gm := tm.Game
+		if gm == nil {
+			continue
+		}
 		if err := bolt.MkBucketPath(gm.mPath); err != nil {
 			return err
 		}
@@ -319,9 +557,17 @@ func (a *ArchivedGamejam) Save() error {
 			if err = bolt.SetValue(ss.mPath, "description", ss.Description); err != nil {
 				return err
 			}
-			if err = bolt.SetValue(ss.mPath, "image", ss.Image); err != nil {
+			if err = bolt.SetValue(ss.mPath, "imagehash", ss.ImageHash); err != nil {
+				return err
+			}
+			if err = bolt.SetValue(ss.mPath, "thumbnailhash", ss.ThumbnailHash); err != nil {
 				return err
 			}
+			for sz, h := range ss.Thumbnails {
+				if err = bolt.SetValue(append(ss.mPath, "thumbnails"), strconv.Itoa(sz), h); err != nil {
+					return err
+				}
+			}
 			if err = bolt.SetValue(ss.mPath, "filetype", ss.Filetype); err != nil {
 				return err
 			}
@@ -335,16 +581,275 @@ func (a *ArchivedGamejam) Save() error {
 		}
 		bolt.SetValue(vt.mPath, "voterstatus", vt.VoterStatus)
 		bolt.SetValue(vt.mPath, "discovery", vt.Discovery)
+		if err = saveCategoryChoices(bolt, vt.mPath, vt.CategoryChoices); err != nil {
+			return err
+		}
 	}
 	// And the rankings
-	if err = bolt.MkBucketPath([]string{"jam", "rankings"}); err != nil {
+	if err = saveRankings(bolt, []string{"jam"}, a.Rankings, a.RankPlaces, a.RankTieBreaks); err != nil {
+		return err
+	}
+	// The judging
+	if err = bolt.SetValue([]string{"jam"}, "judgecriteria", strings.Join(a.JudgeCriteria, ",")); err != nil {
+		return err
+	}
+	if err = bolt.SetInt([]string{"jam"}, "judgeweight", a.JudgeWeight); err != nil {
 		return err
 	}
-	for kr, vr := range a.Rankings {
-		if err = bolt.SetValue([]string{"jam", "rankings"}, strconv.Itoa(kr), vr); err != nil {
+	for _, js := range a.JudgeScores {
+		for c, sc := range js.Scores {
+			if err = bolt.SetInt(js.mPath, c, sc); err != nil {
+				return err
+			}
+		}
+	}
+	for i, cs := range a.CombinedStandings {
+		csPath := []string{"jam", "combined", strconv.Itoa(i)}
+		if err = bolt.SetValue(csPath, "team", cs.Team.UUID); err != nil {
+			return err
+		}
+		if err = bolt.SetInt(csPath, "rank", cs.Rank); err != nil {
+			return err
+		}
+		if err = bolt.SetInt(csPath, "publicrank", cs.PublicRank); err != nil {
+			return err
+		}
+		if err = bolt.SetValue(csPath, "judgeaverage", strconv.FormatFloat(cs.JudgeAverage, 'f', -1, 64)); err != nil {
+			return err
+		}
+		if err = bolt.SetValue(csPath, "combined", strconv.FormatFloat(cs.Combined, 'f', -1, 64)); err != nil {
+			return err
+		}
+	}
+	// And the award categories with their rankings
+	for i, cat := range a.Categories {
+		if err = bolt.SetValue(cat.mPath, "name", cat.Name); err != nil {
+			return err
+		}
+		if err = bolt.SetInt(cat.mPath, "order", i); err != nil {
+			return err
+		}
+		if err = bolt.SetValue(cat.mPath, "coinflips", strings.Join(cat.CoinFlips, ",")); err != nil {
+			return err
+		}
+		if err = saveRankings(bolt, cat.mPath, cat.Rankings, cat.RankPlaces, cat.RankTieBreaks); err != nil {
 			return err
 		}
 	}
 
 	return nil
 }
+
+// LoadAllCategories loads the archived jam's award categories and their rankings
+func (a *ArchivedGamejam) LoadAllCategories(openbolt Store) []ArchivedCategory {
+	var ret []ArchivedCategory
+	catIds, err := openbolt.GetBucketList([]string{"jam", "categories"})
+	if err != nil {
+		return ret
+	}
+	order := make(map[string]int)
+	for _, v := range catIds {
+		cat := ArchivedCategory{Category: *NewCategory(v)}
+		if cat.Name, err = openbolt.GetValue(cat.mPath, "name"); err != nil || cat.Name == "" {
+			continue
+		}
+		if flips, _ := openbolt.GetValue(cat.mPath, "coinflips"); flips != "" {
+			cat.CoinFlips = strings.Split(flips, ",")
+		}
+		if cat.Rankings, cat.RankPlaces, cat.RankTieBreaks, err = loadRankings(openbolt, cat.mPath); err != nil {
+			continue
+		}
+		order[cat.UUID], _ = openbolt.GetInt(cat.mPath, "order")
+		ret = append(ret, cat)
+	}
+	sort.SliceStable(ret, func(i, j int) bool {
+		return order[ret[i].UUID] < order[ret[j].UUID]
+	})
+	return ret
+}
+
+// LoadAllJudgeScores loads the archived jam's judge scores
+func (a *ArchivedGamejam) LoadAllJudgeScores(openbolt Store) []JudgeScore {
+	var ret []JudgeScore
+	scoresPath := []string{"jam", "judgescores"}
+	judges, err := openbolt.GetBucketList(scoresPath)
+	if err != nil {
+		return ret
+	}
+	for _, jdg := range judges {
+		var tmIds []string
+		if tmIds, err = openbolt.GetBucketList(append(scoresPath, jdg)); err != nil {
+			continue
+		}
+		for _, tmId := range tmIds {
+			if js, err := NewJudgeScore(jdg, tmId); err == nil {
+				js.Scores = loadScoreValues(openbolt, js.mPath)
+				ret = append(ret, *js)
+			}
+		}
+	}
+	return ret
+}
+
+// LoadCombinedStandings loads the archived jam's combined standings
+func (a *ArchivedGamejam) LoadCombinedStandings(openbolt Store) []CombinedStanding {
+	var ret []CombinedStanding
+	idxs, err := openbolt.GetBucketList([]string{"jam", "combined"})
+	if err != nil {
+		return ret
+	}
+	// The buckets are the standing indexes, read them in numeric order
+	for i := range idxs {
+		csPath := []string{"jam", "combined", strconv.Itoa(i)}
+		cs := CombinedStanding{}
+		tmId, err := openbolt.GetValue(csPath, "team")
+		if err != nil || tmId == "" {
+			continue
+		}
+		cs.Team = Team{UUID: tmId}
+		for _, tm := range a.Teams {
+			if tm.UUID == tmId {
+				cs.Team = tm
+				break
+			}
+		}
+		cs.Rank, _ = openbolt.GetInt(csPath, "rank")
+		cs.PublicRank, _ = openbolt.GetInt(csPath, "publicrank")
+		if v, err := openbolt.GetValue(csPath, "judgeaverage"); err == nil {
+			cs.JudgeAverage, _ = strconv.ParseFloat(v, 64)
+		}
+		if v, err := openbolt.GetValue(csPath, "combined"); err == nil {
+			cs.Combined, _ = strconv.ParseFloat(v, 64)
+		}
+		ret = append(ret, cs)
+	}
+	return ret
+}
+
+// loadRankings reads the rankings, places and tie-breaks stored under path
+func loadRankings(openbolt Store, path []string) ([]string, []int, []string, error) {
+	var uuids, tiebreaks []string
+	var places []int
+	rankPath := append(append([]string{}, path...), "rankings")
+	placePath := append(append([]string{}, path...), "rankplaces")
+	tbPath := append(append([]string{}, path...), "ranktiebreaks")
+	ranks, err := openbolt.GetKeyList(rankPath)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	// The keys are the ranking indexes, read them in numeric order
+	for i := range ranks {
+		var tmUUID string
+		if tmUUID, err = openbolt.GetValue(rankPath, strconv.Itoa(i)); err != nil {
+			return nil, nil, nil, err
+		}
+		uuids = append(uuids, tmUUID)
+	}
+	for i := range uuids {
+		// Jams archived before ties were recorded just have a place for each team
+		place, err := openbolt.GetInt(placePath, strconv.Itoa(i))
+		if err != nil || place == 0 {
+			place = i + 1
+		}
+		places = append(places, place)
+		tb, _ := openbolt.GetValue(tbPath, strconv.Itoa(i))
+		tiebreaks = append(tiebreaks, tb)
+	}
+	return uuids, places, tiebreaks, nil
+}
+
+// saveRankings writes rankings, places and tie-breaks under path
+func saveRankings(openbolt Store, path []string, uuids []string, places []int, tiebreaks []string) error {
+	var err error
+	rankPath := append(append([]string{}, path...), "rankings")
+	placePath := append(append([]string{}, path...), "rankplaces")
+	tbPath := append(append([]string{}, path...), "ranktiebreaks")
+	if err = openbolt.MkBucketPath(rankPath); err != nil {
+		return err
+	}
+	for kr, vr := range uuids {
+		if err = openbolt.SetValue(rankPath, strconv.Itoa(kr), vr); err != nil {
+			return err
+		}
+		if kr < len(places) {
+			if err = openbolt.SetInt(placePath, strconv.Itoa(kr), places[kr]); err != nil {
+				return err
+			}
+		}
+		if kr < len(tiebreaks) {
+			if err = openbolt.SetValue(tbPath, strconv.Itoa(kr), tiebreaks[kr]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// GetTallyMethodName returns the display name of the archived jam's tally method
+func (a *ArchivedGamejam) GetTallyMethodName() string {
+	return getTallyMethod(a.TallyMethod).Name()
+}
+
+// teamNames returns the names of the teams with the given UUIDs
+// UUIDs that don't match a team are left as they are
+func (a *ArchivedGamejam) teamNames(uuids []string) []string {
+	ret := make([]string, len(uuids))
+	for k, v := range uuids {
+		ret[k] = v
+		for _, tv := range a.Teams {
+			if tv.UUID == v {
+				ret[k] = tv.Name
+				break
+			}
+		}
+	}
+	return ret
+}
+
+// GetTeamById returns the archived jam's team with the given id
+func (a *ArchivedGamejam) GetTeamById(id string) (*Team, error) {
+	for i := range a.Teams {
+		if a.Teams[i].UUID == id {
+			return &a.Teams[i], nil
+		}
+	}
+	return nil, errors.New("Invalid Team Id given")
+}
+
+// ArchivedStanding is one team's place in an archived jam's rankings
+type ArchivedStanding struct {
+	Place    int
+	Team     Team
+	TieBreak string // The tie-break that put the team here, if any
+}
+
+// standings pairs rankings with the teams they refer to
+// Rankings for teams that aren't in the archive are skipped
+func (a *ArchivedGamejam) standings(uuids []string, places []int, tiebreaks []string) []ArchivedStanding {
+	var ret []ArchivedStanding
+	for i, id := range uuids {
+		tm, err := a.GetTeamById(id)
+		if err != nil {
+			continue
+		}
+		st := ArchivedStanding{Place: i + 1, Team: *tm}
+		if i < len(places) {
+			st.Place = places[i]
+		}
+		if i < len(tiebreaks) {
+			st.TieBreak = tiebreaks[i]
+		}
+		ret = append(ret, st)
+	}
+	return ret
+}
+
+// GetArchivedJam returns the archived jam with the given id
+func (arc *Archive) GetArchivedJam(id string) (*ArchivedGamejam, error) {
+	for i := range arc.Jams {
+		if arc.Jams[i].UUID == id {
+			return &arc.Jams[i], nil
+		}
+	}
+	return nil, errors.New("Couldn't find archived jam: " + id)
+}
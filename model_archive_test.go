@@ -0,0 +1,234 @@
+package main
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// checkField reports a field that didn't come back the way it went in
+func checkField(t *testing.T, name string, got, want interface{}) {
+	t.Helper()
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("%s = %#v, want %#v", name, got, want)
+	}
+}
+
+// newArchiveTestJam fills the current jam with everything an archive keeps
+func newArchiveTestJam(t *testing.T) {
+	t.Helper()
+	var err error
+	if m, err = NewModelWithStorage(NewMemStorage()); err != nil {
+		t.Fatalf("Error making model: %v", err)
+	}
+	gj := m.jam
+	gj.Name = "Archive Test Jam"
+	gj.Date = time.Date(2026, 3, 14, 9, 30, 0, 0, time.UTC)
+	gj.TallyMethod = TallyCondorcet
+	// Not the default order
+	gj.TieBreaks = nil
+	for i := len(defaultTieBreaks) - 1; i >= 0; i-- {
+		gj.TieBreaks = append(gj.TieBreaks, defaultTieBreaks[i])
+	}
+
+	var tmIds []string
+	for i, nm := range []string{"Alpha", "Bravo", "Charlie"} {
+		tm := NewTeam("team-" + strconv.Itoa(i))
+		tm.Name = nm
+		for j := 0; j < 2; j++ {
+			mbr, _ := NewTeamMember(tm.UUID, tm.UUID+"-member-"+strconv.Itoa(j))
+			mbr.Name = nm + " Member " + strconv.Itoa(j)
+			mbr.SlackId = "U" + strconv.Itoa(i) + strconv.Itoa(j)
+			mbr.Twitter = "@" + nm + strconv.Itoa(j)
+			mbr.Email = nm + strconv.Itoa(j) + "@example.com"
+			mbr.Public = j == 0
+			tm.AddTeamMember(mbr)
+		}
+		tm.Game.Name = nm + " Quest"
+		tm.Game.Link = "https://example.com/" + nm
+		tm.Game.Description = "The game that " + nm + " made"
+		tm.Game.Framework = "Framework " + strconv.Itoa(i)
+		ss, _ := NewScreenshot(tm.UUID, tm.UUID+"-shot")
+		ss.Description = nm + " title screen"
+		ss.ImageHash = "image-" + nm
+		ss.ThumbnailHash = "thumb-200-" + nm
+		ss.Thumbnails = map[int]string{200: "thumb-200-" + nm, 400: "thumb-400-" + nm}
+		ss.Filetype = "png"
+		tm.Game.Screenshots = append(tm.Game.Screenshots, *ss)
+		if err = gj.AddTeam(tm); err != nil {
+			t.Fatalf("Error adding team: %v", err)
+		}
+		tmIds = append(tmIds, tm.UUID)
+	}
+	gj.CoinFlips = []string{tmIds[1], tmIds[0]}
+
+	cat := NewCategory("category-0")
+	cat.Name = "Best Art"
+	cat.CoinFlips = []string{tmIds[2]}
+	if err = gj.AddCategory(cat); err != nil {
+		t.Fatalf("Error adding category: %v", err)
+	}
+
+	gj.JudgeCriteria = []string{"Fun", "Theme"}
+	gj.JudgeWeight = 40
+	js, _ := NewJudgeScore("judge@example.com", tmIds[0])
+	js.Scores["Fun"] = 8
+	js.Scores["Theme"] = 6
+	if err = gj.SetJudgeScore(js); err != nil {
+		t.Fatalf("Error setting judge score: %v", err)
+	}
+
+	for i := 0; i < 3; i++ {
+		vt, _ := NewVote("client-"+strconv.Itoa(i), gj.Date.Add(time.Duration(i)*time.Minute))
+		vt.SetChoices([]string{tmIds[i%3], tmIds[(i+1)%3], tmIds[(i+2)%3]})
+		vt.SetCategoryChoices(cat.UUID, []string{tmIds[(i+2)%3]})
+		vt.VoterStatus = "visitor"
+		vt.Discovery = "A friend"
+		if err = gj.AddVote(vt); err != nil {
+			t.Fatalf("Error adding vote: %v", err)
+		}
+	}
+}
+
+func TestArchiveRoundTrip(t *testing.T) {
+	newArchiveTestJam(t)
+	want := m.archivedCurrentJam()
+	if len(want.Rankings) == 0 || len(want.Categories[0].Rankings) == 0 || len(want.CombinedStandings) == 0 {
+		t.Fatal("The test jam doesn't have any results to archive")
+	}
+	if err := want.Save(m.storage); err != nil {
+		t.Fatalf("Error saving archive: %v", err)
+	}
+	got, err := NewArchivedGamejam(m.storage, want.UUID)
+	if err != nil {
+		t.Fatalf("Error loading archive: %v", err)
+	}
+
+	checkField(t, "UUID", got.UUID, want.UUID)
+	checkField(t, "Name", got.Name, want.Name)
+	if !got.Date.Equal(want.Date) {
+		t.Errorf("Date = %v, want %v", got.Date, want.Date)
+	}
+	checkField(t, "TallyMethod", got.TallyMethod, want.TallyMethod)
+	checkField(t, "TieBreaks", got.TieBreaks, want.TieBreaks)
+	checkField(t, "CoinFlips", got.CoinFlips, want.CoinFlips)
+	checkField(t, "Rankings", got.Rankings, want.Rankings)
+	checkField(t, "RankPlaces", got.RankPlaces, want.RankPlaces)
+	checkField(t, "RankTieBreaks", got.RankTieBreaks, want.RankTieBreaks)
+	checkField(t, "JudgeCriteria", got.JudgeCriteria, want.JudgeCriteria)
+	checkField(t, "JudgeWeight", got.JudgeWeight, want.JudgeWeight)
+
+	if len(got.Teams) != len(want.Teams) {
+		t.Fatalf("Got %d teams, want %d", len(got.Teams), len(want.Teams))
+	}
+	for i, wtm := range want.Teams {
+		gtm, err := got.GetTeamById(wtm.UUID)
+		if err != nil {
+			t.Errorf("Team %s is missing", wtm.UUID)
+			continue
+		}
+		pfx := "Team " + wtm.Name + " "
+		checkField(t, pfx+"Name", gtm.Name, wtm.Name)
+		if len(gtm.Members) != len(wtm.Members) {
+			t.Errorf("%sgot %d members, want %d", pfx, len(gtm.Members), len(wtm.Members))
+		}
+		for _, wmbr := range wtm.Members {
+			gmbr, err := gtm.GetTeamMemberById(wmbr.UUID)
+			if err != nil {
+				t.Errorf("%smember %s is missing", pfx, wmbr.UUID)
+				continue
+			}
+			mpfx := pfx + "member " + wmbr.Name + " "
+			checkField(t, mpfx+"Name", gmbr.Name, wmbr.Name)
+			checkField(t, mpfx+"SlackId", gmbr.SlackId, wmbr.SlackId)
+			checkField(t, mpfx+"Twitter", gmbr.Twitter, wmbr.Twitter)
+			checkField(t, mpfx+"Email", gmbr.Email, wmbr.Email)
+			checkField(t, mpfx+"Public", gmbr.Public, wmbr.Public)
+		}
+		if gtm.Game == nil {
+			t.Errorf("%sgame is missing", pfx)
+			continue
+		}
+		wgm, ggm := want.Teams[i].Game, gtm.Game
+		checkField(t, pfx+"game Name", ggm.Name, wgm.Name)
+		checkField(t, pfx+"game TeamId", ggm.TeamId, wgm.TeamId)
+		checkField(t, pfx+"game Link", ggm.Link, wgm.Link)
+		checkField(t, pfx+"game Description", ggm.Description, wgm.Description)
+		checkField(t, pfx+"game Framework", ggm.Framework, wgm.Framework)
+		if len(ggm.Screenshots) != len(wgm.Screenshots) {
+			t.Errorf("%sgot %d screenshots, want %d", pfx, len(ggm.Screenshots), len(wgm.Screenshots))
+		}
+		for _, wss := range wgm.Screenshots {
+			gss, err := ggm.GetScreenshot(wss.UUID)
+			if err != nil {
+				t.Errorf("%sscreenshot %s is missing", pfx, wss.UUID)
+				continue
+			}
+			spfx := pfx + "screenshot "
+			checkField(t, spfx+"Description", gss.Description, wss.Description)
+			checkField(t, spfx+"ImageHash", gss.ImageHash, wss.ImageHash)
+			checkField(t, spfx+"ThumbnailHash", gss.ThumbnailHash, wss.ThumbnailHash)
+			checkField(t, spfx+"Thumbnails", gss.Thumbnails, wss.Thumbnails)
+			checkField(t, spfx+"Filetype", gss.Filetype, wss.Filetype)
+		}
+	}
+
+	if len(got.Categories) != len(want.Categories) {
+		t.Fatalf("Got %d categories, want %d", len(got.Categories), len(want.Categories))
+	}
+	for i, wcat := range want.Categories {
+		gcat := got.Categories[i]
+		pfx := "Category " + wcat.Name + " "
+		checkField(t, pfx+"UUID", gcat.UUID, wcat.UUID)
+		checkField(t, pfx+"Name", gcat.Name, wcat.Name)
+		checkField(t, pfx+"CoinFlips", gcat.CoinFlips, wcat.CoinFlips)
+		checkField(t, pfx+"Rankings", gcat.Rankings, wcat.Rankings)
+		checkField(t, pfx+"RankPlaces", gcat.RankPlaces, wcat.RankPlaces)
+		checkField(t, pfx+"RankTieBreaks", gcat.RankTieBreaks, wcat.RankTieBreaks)
+	}
+
+	if len(got.JudgeScores) != len(want.JudgeScores) {
+		t.Fatalf("Got %d judge scores, want %d", len(got.JudgeScores), len(want.JudgeScores))
+	}
+	for i, wjs := range want.JudgeScores {
+		gjs := got.JudgeScores[i]
+		checkField(t, "JudgeScore Judge", gjs.Judge, wjs.Judge)
+		checkField(t, "JudgeScore Team", gjs.Team, wjs.Team)
+		checkField(t, "JudgeScore Scores", gjs.Scores, wjs.Scores)
+	}
+
+	if len(got.CombinedStandings) != len(want.CombinedStandings) {
+		t.Fatalf("Got %d combined standings, want %d", len(got.CombinedStandings), len(want.CombinedStandings))
+	}
+	for i, wcs := range want.CombinedStandings {
+		gcs := got.CombinedStandings[i]
+		pfx := "CombinedStanding " + strconv.Itoa(i) + " "
+		checkField(t, pfx+"Rank", gcs.Rank, wcs.Rank)
+		checkField(t, pfx+"Team", gcs.Team.UUID, wcs.Team.UUID)
+		checkField(t, pfx+"PublicRank", gcs.PublicRank, wcs.PublicRank)
+		checkField(t, pfx+"JudgeAverage", gcs.JudgeAverage, wcs.JudgeAverage)
+		checkField(t, pfx+"Combined", gcs.Combined, wcs.Combined)
+	}
+
+	if len(got.Votes) != len(want.Votes) {
+		t.Fatalf("Got %d votes, want %d", len(got.Votes), len(want.Votes))
+	}
+	for _, wvt := range want.Votes {
+		var gvt *Vote
+		for i := range got.Votes {
+			if got.Votes[i].ClientId == wvt.ClientId && got.Votes[i].Timestamp.Equal(wvt.Timestamp) {
+				gvt = &got.Votes[i]
+			}
+		}
+		if gvt == nil {
+			t.Errorf("Vote from %s is missing", wvt.ClientId)
+			continue
+		}
+		pfx := "Vote from " + wvt.ClientId + " "
+		checkField(t, pfx+"Choices", gvt.Choices, wvt.Choices)
+		checkField(t, pfx+"CategoryChoices", gvt.CategoryChoices, wvt.CategoryChoices)
+		checkField(t, pfx+"VoterStatus", gvt.VoterStatus, wvt.VoterStatus)
+		checkField(t, pfx+"Discovery", gvt.Discovery, wvt.Discovery)
+	}
+}
@@ -0,0 +1,309 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/boltdb/bolt"
+)
+
+/**
+ * Backups
+ * A backup is a copy of the main DB and every archived jam's DB, as bolt
+ * files, taken inside a read transaction so it's consistent even while the
+ * site is running. Backups are taken every BackupInterval minutes (if anything has
+ * changed) and before anything that throws data away, and only the newest
+ * BackupKeep are kept.
+ * Screenshot files aren't copied, they're never changed or removed once
+ * they're written, so the ones a backup refers to are still there.
+ */
+
+// The directory that backups are kept in, one directory per backup
+const BackupDir = DataDir + "/backups"
+
+// The format of the time at the start of a backup's name
+const backupTimeFormat = "20060102-150405"
+
+// Backup defaults
+const (
+	DefaultBackupInterval = 60 // Minutes, 0 turns scheduled backups off
+	DefaultBackupKeep     = 24
+)
+
+// Backup is a backup in BackupDir
+type Backup struct {
+	Name   string // The directory name, <time>_<reason>, or <time>.<n>_<reason>
+	Time   time.Time
+	Reason string // What the backup was taken for: scheduled, manual, archive...
+	Files  []string
+	Size   int64
+
+	seq int // Which backup it was in its second, from the .<n>
+}
+
+// backup takes a backup of all of the DBs, then drops the oldest backups
+// past BackupKeep
+// The caller has to hold m.mu, so that nothing has the DBs open
+func (m *model) backup(reason string) (*Backup, error) {
+	bk, err := m.snapshot(reason)
+	if err != nil {
+		return nil, err
+	}
+	if err = m.rotateBackups(); err != nil {
+		fmt.Println("Error removing old backups: " + err.Error())
+	}
+	return bk, nil
+}
+
+// snapshot takes a backup of all of the DBs
+func (m *model) snapshot(reason string) (*Backup, error) {
+	// Keep the DB from being opened until the copies are made
+	m.dbMu.Lock()
+	defer m.dbMu.Unlock()
+	if m.dbOpened > 0 {
+		return nil, errors.New("The database is in use")
+	}
+	names, err := m.storage.List()
+	if err != nil {
+		return nil, err
+	}
+	if err = os.MkdirAll(BackupDir, os.ModePerm); err != nil {
+		return nil, errors.New("Unable to create backup directory: " + err.Error())
+	}
+	now := time.Now()
+	name := now.Format(backupTimeFormat)
+	// Backups taken in the same second get numbered, so they still sort in
+	// the order they were taken
+	seq := -1
+	bks, _ := ListBackups()
+	for _, bk := range bks {
+		if bk.Time.Equal(now.Truncate(time.Second)) && bk.seq > seq {
+			seq = bk.seq
+		}
+	}
+	if seq >= 0 {
+		name += "." + strconv.Itoa(seq+1)
+	}
+	name += "_" + reason
+	// Copy everything into a temp directory, then move it into place, so a
+	// backup that fails part way through never shows up in the list
+	tmp, err := ioutil.TempDir(BackupDir, ".tmp-"+name)
+	if err != nil {
+		return nil, errors.New("Unable to create backup directory: " + err.Error())
+	}
+	for _, nm := range names {
+		if err = m.storage.Snapshot(nm, filepath.Join(tmp, nm)); err != nil {
+			os.RemoveAll(tmp)
+			return nil, errors.New("Unable to back up " + nm + ": " + err.Error())
+		}
+	}
+	if err = os.Rename(tmp, filepath.Join(BackupDir, name)); err != nil {
+		os.RemoveAll(tmp)
+		return nil, errors.New("Unable to save backup: " + err.Error())
+	}
+	fmt.Println("Backed up the database to " + filepath.Join(BackupDir, name))
+	return loadBackup(name)
+}
+
+// backupBefore takes a backup before something that throws data away, the
+// action shouldn't go ahead if it returns an error
+func (m *model) backupBefore(action string) error {
+	if _, err := m.backup(action); err != nil {
+		return errors.New("Unable to back up first: " + err.Error())
+	}
+	return nil
+}
+
+// snapshotDB copies the bolt DB at src to dst inside a read transaction
+func snapshotDB(src, dst string) error {
+	db, err := bolt.Open(src, 0600, &bolt.Options{ReadOnly: true, Timeout: 5 * time.Second})
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	return db.View(func(tx *bolt.Tx) error {
+		return tx.CopyFile(dst, 0600)
+	})
+}
+
+// loadBackup reads the backup with the given name from BackupDir
+func loadBackup(name string) (*Backup, error) {
+	pts := strings.SplitN(name, "_", 2)
+	if len(pts) != 2 || len(pts[0]) < len(backupTimeFormat) || name != filepath.Base(name) {
+		return nil, errors.New("Invalid backup name: " + name)
+	}
+	bk := &Backup{Name: name, Reason: pts[1]}
+	var err error
+	if bk.Time, err = time.ParseInLocation(backupTimeFormat, pts[0][:len(backupTimeFormat)], time.Local); err != nil {
+		return nil, errors.New("Invalid backup name: " + name)
+	}
+	if sq := pts[0][len(backupTimeFormat):]; sq != "" {
+		if bk.seq, err = strconv.Atoi(strings.TrimPrefix(sq, ".")); err != nil || sq[0] != '.' {
+			return nil, errors.New("Invalid backup name: " + name)
+		}
+	}
+	fis, err := ioutil.ReadDir(filepath.Join(BackupDir, name))
+	if err != nil {
+		return nil, errors.New("Couldn't find backup: " + name)
+	}
+	for _, fi := range fis {
+		if !fi.IsDir() && strings.HasSuffix(fi.Name(), ".db") {
+			bk.Files = append(bk.Files, fi.Name())
+			bk.Size += fi.Size()
+		}
+	}
+	return bk, nil
+}
+
+// SizeText returns the size of the backup for people to read
+func (bk Backup) SizeText() string {
+	return formatBytes(int(bk.Size))
+}
+
+// ListBackups returns the backups in BackupDir, newest first
+func ListBackups() ([]Backup, error) {
+	fis, err := ioutil.ReadDir(BackupDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var ret []Backup
+	for _, fi := range fis {
+		if !fi.IsDir() || strings.HasPrefix(fi.Name(), ".") {
+			continue
+		}
+		if bk, err := loadBackup(fi.Name()); err == nil {
+			ret = append(ret, *bk)
+		}
+	}
+	sort.SliceStable(ret, func(i, j int) bool {
+		if !ret[i].Time.Equal(ret[j].Time) {
+			return ret[i].Time.After(ret[j].Time)
+		}
+		return ret[i].seq > ret[j].seq
+	})
+	return ret, nil
+}
+
+// rotateBackups removes the oldest backups past BackupKeep
+func (m *model) rotateBackups() error {
+	bks, err := ListBackups()
+	if err != nil {
+		return err
+	}
+	keep := m.site.BackupKeep
+	if keep < 1 {
+		keep = 1
+	}
+	for i := keep; i < len(bks); i++ {
+		if err = os.RemoveAll(filepath.Join(BackupDir, bks[i].Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// changedSinceBackup returns whether any of the DBs have been written to
+// since the newest backup was taken
+func (m *model) changedSinceBackup() bool {
+	bks, err := ListBackups()
+	if err != nil || len(bks) == 0 {
+		return true
+	}
+	names, err := m.storage.List()
+	if err != nil {
+		return true
+	}
+	for _, nm := range names {
+		// Backup times are to the second, so anything in the same second counts
+		if mt, err := m.storage.ModTime(nm); err != nil || !mt.Before(bks[0].Time) {
+			return true
+		}
+	}
+	return false
+}
+
+// RestoreBackup replaces the DBs with the ones in the named backup
+// The DBs are backed up first, so a restore can be undone. This is meant to
+// be run from the command line, while the site isn't running.
+func (m *model) RestoreBackup(name string) error {
+	bk, err := loadBackup(name)
+	if err != nil {
+		return err
+	}
+	if len(bk.Files) == 0 {
+		return errors.New("Backup " + name + " doesn't have any DB files")
+	}
+	// Old backups aren't dropped until after, the one being restored could
+	// be the oldest
+	if _, err = m.snapshot("restore"); err != nil {
+		return errors.New("Unable to back up first: " + err.Error())
+	}
+	for _, fn := range bk.Files {
+		if err = m.storage.Restore(fn, filepath.Join(BackupDir, name, fn)); err != nil {
+			return errors.New("Error restoring " + fn + ": " + err.Error())
+		}
+	}
+	return m.rotateBackups()
+}
+
+// copyFile copies src over dst, by way of a temp file so that dst is never
+// left half written
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	tmp, err := ioutil.TempFile(filepath.Dir(dst), filepath.Base(dst)+".tmp")
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(tmp, in); err == nil {
+		err = tmp.Sync()
+	}
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Chmod(tmp.Name(), 0600)
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), dst)
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+	}
+	return err
+}
+
+// runBackups takes a backup every BackupInterval minutes until the app exits
+// If nothing has changed since the last one, it's skipped
+func (m *model) runBackups() {
+	for {
+		m.mu.Lock()
+		interval := m.site.BackupInterval
+		m.mu.Unlock()
+		if interval <= 0 {
+			return
+		}
+		time.Sleep(time.Duration(interval) * time.Minute)
+		m.mu.Lock()
+		if m.changedSinceBackup() {
+			if _, err := m.backup("scheduled"); err != nil {
+				fmt.Println("Error backing up the database: " + err.Error())
+			}
+		}
+		m.mu.Unlock()
+	}
+}
@@ -0,0 +1,313 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+/**
+ * Ballot Exports
+ * The votes of a jam, current or archived, can be downloaded so that the
+ * count can be checked with other tools. The formats are:
+ *   csv   One row per vote, with the ranked games in columns
+ *   json  Every vote, with the teams they rank and any award category ballots
+ *   blt   The ballot file format read by OpenSTV and most STV counters
+ *   abif  The Aggregated Ballot Information Format (.abif, also read as .ranks)
+ * A ballot export is for the overall ranking, or for one award category.
+ */
+
+// The formats ballots can be exported in, by extension, with their content type
+var ballotContentTypes = map[string]string{
+	"csv":  "text/csv; charset=utf-8",
+	"json": "application/json",
+	"blt":  "text/plain; charset=utf-8",
+	"abif": "text/plain; charset=utf-8",
+}
+
+func validBallotFormat(format string) bool {
+	_, ok := ballotContentTypes[format]
+	return ok
+}
+
+// ballotSet is the votes being exported, along with what they vote on
+type ballotSet struct {
+	JamId    string
+	JamName  string
+	JamDate  time.Time
+	Category *Category // Set if these are an award category's ballots
+	Teams    []Team
+	Votes    []Vote
+}
+
+// newBallotSet returns the ballots for a jam, sorted by when they were cast
+// If catId is set, it's that award category's ballots instead
+func newBallotSet(id, name string, dt time.Time, cats []Category, teams []Team, votes []Vote, catId string) (*ballotSet, error) {
+	bs := &ballotSet{JamId: id, JamName: name, JamDate: dt, Teams: teams}
+	if catId != "" {
+		for i := range cats {
+			if cats[i].UUID == catId {
+				bs.Category = &cats[i]
+				break
+			}
+		}
+		if bs.Category == nil {
+			return nil, errors.New("Invalid Category Id given")
+		}
+		bs.Votes = votesForCategory(votes, catId)
+	} else {
+		bs.Votes = append([]Vote{}, votes...)
+	}
+	sort.SliceStable(bs.Votes, func(i, j int) bool {
+		return bs.Votes[i].Timestamp.Before(bs.Votes[j].Timestamp)
+	})
+	return bs, nil
+}
+
+// getBallotSet returns the ballots of the jam with the given id
+// "current" (or no id) is the current jam
+func (m *model) getBallotSet(id, catId string) (*ballotSet, error) {
+	if id == "" || id == "current" {
+		return newBallotSet(m.jam.UUID, m.jam.Name, m.jam.Date, m.jam.Categories, m.jam.Teams, m.jam.Votes, catId)
+	}
+	a, err := m.archive.GetArchivedJam(id)
+	if err != nil {
+		return nil, err
+	}
+	var cats []Category
+	for _, cat := range a.Categories {
+		cats = append(cats, cat.Category)
+	}
+	return newBallotSet(a.UUID, a.Name, a.Date, cats, a.Teams, a.Votes, catId)
+}
+
+// Title describes the ballots for the files they're exported to
+func (bs *ballotSet) Title() string {
+	if bs.Category != nil {
+		return bs.JamName + ": " + bs.Category.Name
+	}
+	return bs.JamName
+}
+
+// Filename returns the name of the file the ballots are exported to
+func (bs *ballotSet) Filename(format string) string {
+	fn := jamFilename(bs.JamId, bs.JamName, bs.JamDate) + "_ballots"
+	if bs.Category != nil {
+		fn += "_" + jamFilename("", bs.Category.Name, time.Time{})
+	}
+	return fn + "." + format
+}
+
+// teamIndex returns the index of the team with the given UUID, or -1
+func (bs *ballotSet) teamIndex(id string) int {
+	for i := range bs.Teams {
+		if bs.Teams[i].UUID == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// rankedTeams returns the indexes of the teams a vote ranks, in order
+// Choices for teams that aren't in the jam any more are left out
+func (bs *ballotSet) rankedTeams(vt *Vote) []int {
+	var ret []int
+	for _, ch := range vt.Choices {
+		if idx := bs.teamIndex(ch.Team); idx >= 0 {
+			ret = append(ret, idx)
+		}
+	}
+	return ret
+}
+
+// Write writes the ballots to w in the given format
+func (bs *ballotSet) Write(w io.Writer, format string) error {
+	switch format {
+	case "csv":
+		return bs.writeCSV(w)
+	case "json":
+		return bs.writeJSON(w)
+	case "blt":
+		return bs.writeBLT(w)
+	case "abif":
+		return bs.writeABIF(w)
+	}
+	return errors.New("Invalid export format: " + format)
+}
+
+// writeCSV writes a header row, then a row for each vote
+// The games are in the columns after discovery, first choice first
+func (bs *ballotSet) writeCSV(w io.Writer) error {
+	most := 0
+	for i := range bs.Votes {
+		if len(bs.Votes[i].Choices) > most {
+			most = len(bs.Votes[i].Choices)
+		}
+	}
+	cw := csv.NewWriter(w)
+	hdr := []string{"timestamp", "client_id", "voter_status", "discovery"}
+	for i := 1; i <= most; i++ {
+		hdr = append(hdr, "choice_"+strconv.Itoa(i))
+	}
+	if err := cw.Write(hdr); err != nil {
+		return err
+	}
+	for i := range bs.Votes {
+		vt := &bs.Votes[i]
+		row := []string{vt.Timestamp.Format(time.RFC3339), vt.ClientId, vt.VoterStatus, vt.Discovery}
+		for _, ch := range vt.Choices {
+			nm := ch.Team
+			if idx := bs.teamIndex(ch.Team); idx >= 0 {
+				nm = ballotTeamName(&bs.Teams[idx])
+			}
+			row = append(row, nm)
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+type ballotExport struct {
+	Jam      string          `json:"jam"`
+	JamName  string          `json:"jam_name"`
+	Category *apiCategory    `json:"category,omitempty"`
+	Teams    []apiTeamRef    `json:"teams"`
+	Votes    []apiVote       `json:"votes"`
+	Counts   ballotExportSum `json:"counts"`
+}
+
+type ballotExportSum struct {
+	Votes         int            `json:"votes"`
+	VoterStatuses map[string]int `json:"voter_statuses"`
+}
+
+// writeJSON writes the votes along with the teams that they rank
+func (bs *ballotSet) writeJSON(w io.Writer) error {
+	ret := ballotExport{
+		Jam:     bs.JamId,
+		JamName: bs.JamName,
+		Teams:   []apiTeamRef{},
+		Votes:   []apiVote{},
+		Counts:  ballotExportSum{Votes: len(bs.Votes), VoterStatuses: make(map[string]int)},
+	}
+	if bs.Category != nil {
+		ret.Category = &apiCategory{UUID: bs.Category.UUID, Name: bs.Category.Name}
+	}
+	for i := range bs.Teams {
+		ret.Teams = append(ret.Teams, newApiTeamRef(&bs.Teams[i]))
+	}
+	for i := range bs.Votes {
+		ret.Votes = append(ret.Votes, newApiVote(&bs.Votes[i]))
+		if st := strings.TrimSpace(bs.Votes[i].VoterStatus); st != "" {
+			ret.Counts.VoterStatuses[st]++
+		}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(ret)
+}
+
+// writeBLT writes the ballots in BLT format:
+//
+//	<number of candidates> <number of seats>
+//	<weight> <candidate> <candidate> ... 0     (one line per ballot)
+//	0
+//	"<candidate name>"                         (one line per candidate)
+//	"<title>"
+//
+// Candidates are numbered from 1 in the order the teams are in. There's one seat.
+func (bs *ballotSet) writeBLT(w io.Writer) error {
+	var err error
+	write := func(format string, a ...interface{}) {
+		if err == nil {
+			_, err = fmt.Fprintf(w, format, a...)
+		}
+	}
+	write("%d 1\n", len(bs.Teams))
+	for i := range bs.Votes {
+		write("1")
+		for _, idx := range bs.rankedTeams(&bs.Votes[i]) {
+			write(" %d", idx+1)
+		}
+		write(" 0\n")
+	}
+	write("0\n")
+	for i := range bs.Teams {
+		write("%s\n", bltQuote(ballotTeamName(&bs.Teams[i])))
+	}
+	write("%s\n", bltQuote(bs.Title()))
+	return err
+}
+
+// bltQuote quotes a name for a BLT file, which has no way to escape quotes
+func bltQuote(s string) string {
+	return `"` + strings.Replace(s, `"`, "'", -1) + `"`
+}
+
+// writeABIF writes the ballots in ABIF, with identical ballots counted
+// together in the order they were first cast:
+//
+//	{"title": "<title>"}
+//	=T1:[<candidate name>]     (one line per candidate)
+//	<count>:T1>T2>T3           (one line per distinct ballot)
+func (bs *ballotSet) writeABIF(w io.Writer) error {
+	var err error
+	write := func(format string, a ...interface{}) {
+		if err == nil {
+			_, err = fmt.Fprintf(w, format, a...)
+		}
+	}
+	title, _ := json.Marshal(bs.Title())
+	write("# Ballots exported from %s\n", AppName)
+	write("{\"title\": %s}\n", title)
+	for i := range bs.Teams {
+		write("=T%d:[%s]\n", i+1, abifName(ballotTeamName(&bs.Teams[i])))
+	}
+	var order []string
+	counts := make(map[string]int)
+	for i := range bs.Votes {
+		var toks []string
+		for _, idx := range bs.rankedTeams(&bs.Votes[i]) {
+			toks = append(toks, "T"+strconv.Itoa(idx+1))
+		}
+		key := strings.Join(toks, ">")
+		if _, ok := counts[key]; !ok {
+			order = append(order, key)
+		}
+		counts[key]++
+	}
+	for _, key := range order {
+		if key == "" {
+			// A ballot that doesn't rank anyone
+			write("%d:\n", counts[key])
+		} else {
+			write("%d:%s\n", counts[key], key)
+		}
+	}
+	return err
+}
+
+// abifName makes a name safe to put in the brackets of an ABIF candidate line
+func abifName(s string) string {
+	s = strings.Replace(s, "[", "(", -1)
+	s = strings.Replace(s, "]", ")", -1)
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// ballotTeamName is how a team is named in the ballot exports, by its game if
+// it has one, since that's what people voted for
+func ballotTeamName(tm *Team) string {
+	if tm.Game != nil && tm.Game.Name != "" {
+		return tm.Game.Name + " (" + tm.Name + ")"
+	}
+	return tm.Name
+}
@@ -0,0 +1,583 @@
+package main
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/pborman/uuid"
+)
+
+/**
+ * Jam Bundles
+ * A bundle is a zip file holding everything about one jam, the current one
+ * or an archived one, so that it can be moved to another machine without
+ * copying bolt files around. It holds:
+ *   jam.json       The jam's settings and award categories
+ *   teams.json     The teams, with their members and games
+ *   votes.json     Every ballot
+ *   rankings.json  The results, as they stood when the bundle was made
+ *   judging.json   The judges' scores
+ *   screenshots/   The screenshot and thumbnail files, named by their hash
+ * Importing a bundle adds the jam to the archive, from where it can be
+ * restored as the current jam.
+ */
+
+// The bundle format version, bumped if the layout ever changes in a way that
+// older versions can't read
+const bundleFormat = 1
+
+// The screenshot files are in this directory of the zip
+const bundleScreenshotDir = "screenshots/"
+
+type bundleJam struct {
+	Format        int              `json:"format"`
+	UUID          string           `json:"uuid"`
+	Name          string           `json:"name"`
+	Date          string           `json:"date,omitempty"`
+	TallyMethod   string           `json:"tally_method"`
+	TieBreaks     []string         `json:"tie_breaks"`
+	CoinFlips     []string         `json:"coin_flips"`
+	Categories    []bundleCategory `json:"categories"`
+	JudgeCriteria []string         `json:"judge_criteria"`
+	JudgeWeight   int              `json:"judge_weight"`
+}
+
+type bundleCategory struct {
+	UUID      string   `json:"uuid"`
+	Name      string   `json:"name"`
+	CoinFlips []string `json:"coin_flips"`
+}
+
+type bundleTeam struct {
+	UUID    string      `json:"uuid"`
+	Name    string      `json:"name"`
+	Members []apiMember `json:"members"`
+	Game    *bundleGame `json:"game"`
+}
+
+type bundleGame struct {
+	Name        string             `json:"name"`
+	Link        string             `json:"link"`
+	Description string             `json:"description"`
+	Framework   string             `json:"framework"`
+	Screenshots []bundleScreenshot `json:"screenshots"`
+}
+
+// The image and thumbnails are the hashes of files in screenshots/
+type bundleScreenshot struct {
+	UUID        string         `json:"uuid"`
+	Description string         `json:"description"`
+	Filetype    string         `json:"filetype"`
+	Image       string         `json:"image"`
+	Thumbnail   string         `json:"thumbnail"`
+	Thumbnails  map[int]string `json:"thumbnails,omitempty"`
+}
+
+type bundleRankings struct {
+	Results    []bundleStanding            `json:"results"`
+	Categories map[string][]bundleStanding `json:"categories"`
+	Combined   []bundleCombined            `json:"combined,omitempty"`
+}
+
+type bundleStanding struct {
+	Place    int    `json:"place"`
+	Team     string `json:"team"`
+	TieBreak string `json:"tie_break,omitempty"`
+}
+
+type bundleCombined struct {
+	Rank         int     `json:"rank"`
+	Team         string  `json:"team"`
+	PublicRank   int     `json:"public_rank"`
+	JudgeAverage float64 `json:"judge_average"`
+	Combined     float64 `json:"combined"`
+}
+
+type bundleJudgeScore struct {
+	Judge  string         `json:"judge"`
+	Team   string         `json:"team"`
+	Scores map[string]int `json:"scores"`
+}
+
+// getBundleJam returns the jam with the given id as it goes in a bundle
+// "current" (or no id) is the current jam, with its results as they stand
+func (m *model) getBundleJam(id string) (*ArchivedGamejam, error) {
+	if id == "" || id == "current" {
+		hadUUID := m.jam.UUID != ""
+		gj := m.archivedCurrentJam()
+		if !hadUUID {
+			// Keep the UUID it was just given, so that exporting it again
+			// doesn't make it look like a different jam
+			if err := m.jam.SaveSettings(); err != nil {
+				return nil, err
+			}
+		}
+		return gj, nil
+	}
+	return m.archive.GetArchivedJam(id)
+}
+
+// bundleFilename returns a name for the jam's bundle that says which jam it is
+func (a *ArchivedGamejam) bundleFilename() string {
+	return jamFilename(a.UUID, a.Name, a.Date) + ".zip"
+}
+
+// jamFilename returns a name (without an extension) for files exported from
+// a jam, made from its name and date, so that it's clear which jam they're from
+func jamFilename(id, name string, dt time.Time) string {
+	nm := strings.Trim(regexp.MustCompile("[^a-z0-9]+").ReplaceAllString(strings.ToLower(name), "-"), "-")
+	if nm == "" {
+		nm = "gamejam"
+	}
+	if !dt.IsZero() {
+		return nm + "_" + dt.Format("2006-01-02")
+	}
+	if id != "" {
+		return nm + "_" + id
+	}
+	return nm
+}
+
+// WriteBundle writes the jam to w as a bundle
+func (a *ArchivedGamejam) WriteBundle(w io.Writer) error {
+	zw := zip.NewWriter(w)
+	// The jam
+	bj := bundleJam{
+		Format:        bundleFormat,
+		UUID:          a.UUID,
+		Name:          a.Name,
+		TallyMethod:   a.TallyMethod,
+		TieBreaks:     a.TieBreaks,
+		CoinFlips:     a.CoinFlips,
+		Categories:    []bundleCategory{},
+		JudgeCriteria: a.JudgeCriteria,
+		JudgeWeight:   a.JudgeWeight,
+	}
+	if !a.Date.IsZero() {
+		bj.Date = a.Date.Format(time.RFC3339)
+	}
+	for _, cat := range a.Categories {
+		bj.Categories = append(bj.Categories, bundleCategory{UUID: cat.UUID, Name: cat.Name, CoinFlips: cat.CoinFlips})
+	}
+	if err := writeBundleJSON(zw, "jam.json", bj); err != nil {
+		return err
+	}
+
+	// The teams, and the screenshot files they use
+	teams := []bundleTeam{}
+	images := make(map[string]bool)
+	var imageOrder []string
+	addImage := func(h string) {
+		if h != "" && !images[h] {
+			images[h] = true
+			imageOrder = append(imageOrder, h)
+		}
+	}
+	for _, tm := range a.Teams {
+		bt := bundleTeam{UUID: tm.UUID, Name: tm.Name, Members: []apiMember{}}
+		for _, mbr := range tm.Members {
+			bt.Members = append(bt.Members, apiMember{
+				UUID:    mbr.UUID,
+				Name:    mbr.Name,
+				SlackId: mbr.SlackId,
+				Twitter: mbr.Twitter,
+				Email:   mbr.Email,
+				Public:  mbr.Public,
+			})
+		}
+		if gm := tm.Game; gm != nil {
+			bt.Game = &bundleGame{
+				Name:        gm.Name,
+				Link:        gm.Link,
+				Description: gm.Description,
+				Framework:   gm.Framework,
+				Screenshots: []bundleScreenshot{},
+			}
+			for _, ss := range gm.Screenshots {
+				bt.Game.Screenshots = append(bt.Game.Screenshots, bundleScreenshot{
+					UUID:        ss.UUID,
+					Description: ss.Description,
+					Filetype:    ss.Filetype,
+					Image:       ss.ImageHash,
+					Thumbnail:   ss.ThumbnailHash,
+					Thumbnails:  ss.Thumbnails,
+				})
+				addImage(ss.ImageHash)
+				addImage(ss.ThumbnailHash)
+				for _, h := range ss.Thumbnails {
+					addImage(h)
+				}
+			}
+		}
+		teams = append(teams, bt)
+	}
+	if err := writeBundleJSON(zw, "teams.json", teams); err != nil {
+		return err
+	}
+
+	// The votes
+	votes := []apiVote{}
+	for i := range a.Votes {
+		votes = append(votes, newApiVote(&a.Votes[i]))
+	}
+	if err := writeBundleJSON(zw, "votes.json", votes); err != nil {
+		return err
+	}
+
+	// The results
+	rnk := bundleRankings{
+		Results:    newBundleStandings(a.Rankings, a.RankPlaces, a.RankTieBreaks),
+		Categories: make(map[string][]bundleStanding),
+	}
+	for _, cat := range a.Categories {
+		rnk.Categories[cat.UUID] = newBundleStandings(cat.Rankings, cat.RankPlaces, cat.RankTieBreaks)
+	}
+	for _, cs := range a.CombinedStandings {
+		rnk.Combined = append(rnk.Combined, bundleCombined{
+			Rank:         cs.Rank,
+			Team:         cs.Team.UUID,
+			PublicRank:   cs.PublicRank,
+			JudgeAverage: cs.JudgeAverage,
+			Combined:     cs.Combined,
+		})
+	}
+	if err := writeBundleJSON(zw, "rankings.json", rnk); err != nil {
+		return err
+	}
+
+	// The judging
+	scores := []bundleJudgeScore{}
+	for _, js := range a.JudgeScores {
+		scores = append(scores, bundleJudgeScore{Judge: js.Judge, Team: js.Team, Scores: js.Scores})
+	}
+	if err := writeBundleJSON(zw, "judging.json", scores); err != nil {
+		return err
+	}
+
+	// And finally the screenshot files
+	for _, h := range imageOrder {
+		if err := writeBundleImage(zw, h); err != nil {
+			return err
+		}
+	}
+	return zw.Close()
+}
+
+func writeBundleJSON(zw *zip.Writer, name string, v interface{}) error {
+	f, err := zw.Create(name)
+	if err != nil {
+		return errors.New("Error adding " + name + " to bundle: " + err.Error())
+	}
+	enc := json.NewEncoder(f)
+	enc.SetIndent("", "  ")
+	if err = enc.Encode(v); err != nil {
+		return errors.New("Error writing " + name + " to bundle: " + err.Error())
+	}
+	return nil
+}
+
+func writeBundleImage(zw *zip.Writer, h string) error {
+	src, err := openImage(h)
+	if err != nil {
+		// A missing file shouldn't keep the rest of the jam from being exported
+		fmt.Println("Screenshot file " + h + " is missing, leaving it out of the bundle")
+		return nil
+	}
+	defer src.Close()
+	// Images are already compressed, so they're just stored
+	f, err := zw.CreateHeader(&zip.FileHeader{Name: bundleScreenshotDir + h, Method: zip.Store})
+	if err != nil {
+		return errors.New("Error adding screenshot to bundle: " + err.Error())
+	}
+	if _, err = io.Copy(f, src); err != nil {
+		return errors.New("Error writing screenshot to bundle: " + err.Error())
+	}
+	return nil
+}
+
+func newBundleStandings(uuids []string, places []int, tiebreaks []string) []bundleStanding {
+	ret := []bundleStanding{}
+	for i, id := range uuids {
+		st := bundleStanding{Place: i + 1, Team: id}
+		if i < len(places) {
+			st.Place = places[i]
+		}
+		if i < len(tiebreaks) {
+			st.TieBreak = tiebreaks[i]
+		}
+		ret = append(ret, st)
+	}
+	return ret
+}
+
+// flattenBundleStandings is the reverse of newBundleStandings
+func flattenBundleStandings(sts []bundleStanding) ([]string, []int, []string) {
+	var uuids, tiebreaks []string
+	var places []int
+	for _, st := range sts {
+		uuids = append(uuids, st.Team)
+		places = append(places, st.Place)
+		tiebreaks = append(tiebreaks, st.TieBreak)
+	}
+	return uuids, places, tiebreaks
+}
+
+// validBundleId is whether id from a bundle is a UUID
+// They end up in file names, DB keys and URLs, so anything else is refused
+func validBundleId(id string) bool {
+	return uuid.Parse(id) != nil && filepath.Base(id) == id
+}
+
+// ReadBundle reads a jam out of a bundle
+// The screenshot files are copied into the screenshot store as they're read
+func ReadBundle(r io.ReaderAt, size int64) (*ArchivedGamejam, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, errors.New("Not a jam bundle: " + err.Error())
+	}
+	files := make(map[string]*zip.File)
+	for _, f := range zr.File {
+		files[f.Name] = f
+	}
+
+	var bj bundleJam
+	if err = readBundleJSON(files, "jam.json", &bj); err != nil {
+		return nil, err
+	}
+	if bj.Format < 1 || bj.Format > bundleFormat {
+		return nil, fmt.Errorf("Unsupported bundle format: %d", bj.Format)
+	}
+	if bj.UUID == "" {
+		return nil, errors.New("The bundle's jam doesn't have a UUID")
+	}
+	if !validBundleId(bj.UUID) {
+		return nil, errors.New("The bundle's jam has an invalid UUID: " + bj.UUID)
+	}
+	a := new(ArchivedGamejam)
+	a.UUID = bj.UUID
+	a.Name = bj.Name
+	if bj.Date != "" {
+		if a.Date, err = time.Parse(time.RFC3339, bj.Date); err != nil {
+			return nil, errors.New("Invalid jam date: " + err.Error())
+		}
+	}
+	a.TallyMethod = bj.TallyMethod
+	if a.TallyMethod == "" {
+		a.TallyMethod = TallyCondorcet
+	}
+	a.TieBreaks = cleanTieBreaks(bj.TieBreaks)
+	a.CoinFlips = bj.CoinFlips
+	a.JudgeCriteria = cleanJudgeCriteria(bj.JudgeCriteria)
+	a.JudgeWeight = bj.JudgeWeight
+
+	var rnk bundleRankings
+	if err = readBundleJSON(files, "rankings.json", &rnk); err != nil {
+		return nil, err
+	}
+	a.Rankings, a.RankPlaces, a.RankTieBreaks = flattenBundleStandings(rnk.Results)
+	for _, bc := range bj.Categories {
+		if bc.UUID == "" {
+			continue
+		}
+		if !validBundleId(bc.UUID) {
+			return nil, errors.New("A category in the bundle has an invalid UUID: " + bc.UUID)
+		}
+		cat := ArchivedCategory{Category: *NewCategory(bc.UUID)}
+		cat.Name = bc.Name
+		cat.CoinFlips = bc.CoinFlips
+		cat.Rankings, cat.RankPlaces, cat.RankTieBreaks = flattenBundleStandings(rnk.Categories[bc.UUID])
+		a.Categories = append(a.Categories, cat)
+	}
+
+	// The teams
+	var teams []bundleTeam
+	if err = readBundleJSON(files, "teams.json", &teams); err != nil {
+		return nil, err
+	}
+	for _, bt := range teams {
+		if bt.UUID == "" {
+			return nil, errors.New("A team in the bundle doesn't have a UUID")
+		}
+		if !validBundleId(bt.UUID) {
+			return nil, errors.New("A team in the bundle has an invalid UUID: " + bt.UUID)
+		}
+		tm := NewTeam(bt.UUID)
+		tm.Name = bt.Name
+		for _, bm := range bt.Members {
+			if !validBundleId(bm.UUID) {
+				return nil, errors.New("A team member in the bundle has an invalid UUID: " + bm.UUID)
+			}
+			mbr, err := NewTeamMember(tm.UUID, bm.UUID)
+			if err != nil {
+				return nil, err
+			}
+			mbr.Name = bm.Name
+			mbr.SlackId = bm.SlackId
+			mbr.Twitter = bm.Twitter
+			mbr.Email = bm.Email
+			mbr.Public = bm.Public
+			tm.Members = append(tm.Members, *mbr)
+		}
+		if bg := bt.Game; bg != nil {
+			tm.Game.Name = bg.Name
+			tm.Game.Link = bg.Link
+			tm.Game.Description = bg.Description
+			tm.Game.Framework = bg.Framework
+			for _, bs := range bg.Screenshots {
+				if !validBundleId(bs.UUID) {
+					return nil, errors.New("A screenshot in the bundle has an invalid UUID: " + bs.UUID)
+				}
+				ss, err := NewScreenshot(tm.UUID, bs.UUID)
+				if err != nil {
+					return nil, err
+				}
+				ss.Description = bs.Description
+				ss.Filetype = bs.Filetype
+				if ss.ImageHash, err = importBundleImage(files, bs.Image); err != nil {
+					return nil, err
+				}
+				if ss.ThumbnailHash, err = importBundleImage(files, bs.Thumbnail); err != nil {
+					return nil, err
+				}
+				ss.Thumbnails = make(map[int]string)
+				for sz, h := range bs.Thumbnails {
+					if ss.Thumbnails[sz], err = importBundleImage(files, h); err != nil {
+						return nil, err
+					}
+				}
+				tm.Game.Screenshots = append(tm.Game.Screenshots, *ss)
+			}
+		}
+		a.Teams = append(a.Teams, *tm)
+	}
+	for _, bc := range rnk.Combined {
+		cs := CombinedStanding{
+			Rank:         bc.Rank,
+			Team:         Team{UUID: bc.Team},
+			PublicRank:   bc.PublicRank,
+			JudgeAverage: bc.JudgeAverage,
+			Combined:     bc.Combined,
+		}
+		if tm, err := a.GetTeamById(bc.Team); err == nil {
+			cs.Team = *tm
+		}
+		a.CombinedStandings = append(a.CombinedStandings, cs)
+	}
+
+	// The votes
+	var votes []apiVote
+	if err = readBundleJSON(files, "votes.json", &votes); err != nil {
+		return nil, err
+	}
+	for _, bv := range votes {
+		vt, err := NewVote(bv.ClientId, bv.Timestamp)
+		if err != nil {
+			return nil, errors.New("Invalid vote in bundle: " + err.Error())
+		}
+		vt.SetChoices(bv.Choices)
+		for catId, chcs := range bv.CategoryChoices {
+			vt.SetCategoryChoices(catId, chcs)
+		}
+		vt.VoterStatus = bv.VoterStatus
+		vt.Discovery = bv.Discovery
+		a.Votes = append(a.Votes, *vt)
+	}
+
+	// And the judging
+	var scores []bundleJudgeScore
+	if err = readBundleJSON(files, "judging.json", &scores); err != nil {
+		return nil, err
+	}
+	for _, bs := range scores {
+		js, err := NewJudgeScore(bs.Judge, bs.Team)
+		if err != nil {
+			return nil, errors.New("Invalid judge score in bundle: " + err.Error())
+		}
+		for c, sc := range bs.Scores {
+			js.Scores[c] = sc
+		}
+		a.JudgeScores = append(a.JudgeScores, *js)
+	}
+	return a, nil
+}
+
+func readBundleJSON(files map[string]*zip.File, name string, v interface{}) error {
+	f, ok := files[name]
+	if !ok {
+		return errors.New("The bundle is missing " + name)
+	}
+	rc, err := f.Open()
+	if err != nil {
+		return errors.New("Error reading " + name + " from bundle: " + err.Error())
+	}
+	defer rc.Close()
+	if err = json.NewDecoder(rc).Decode(v); err != nil {
+		return errors.New("Error reading " + name + " from bundle: " + err.Error())
+	}
+	return nil
+}
+
+// importBundleImage copies the screenshot file with hash h out of the bundle
+// and into the screenshot store
+// Files the bundle doesn't have (because they were missing when it was made)
+// are left out, rather than failing the whole import
+func importBundleImage(files map[string]*zip.File, h string) (string, error) {
+	if h == "" {
+		return "", nil
+	}
+	if !validImageHash(h) {
+		return "", errors.New("Invalid screenshot in bundle: " + h)
+	}
+	f, ok := files[bundleScreenshotDir+h]
+	if !ok {
+		fmt.Println("Screenshot file " + h + " isn't in the bundle, leaving it out")
+		return "", nil
+	}
+	rc, err := f.Open()
+	if err != nil {
+		return "", errors.New("Error reading screenshot from bundle: " + err.Error())
+	}
+	defer rc.Close()
+	dat, err := ioutil.ReadAll(rc)
+	if err != nil {
+		return "", errors.New("Error reading screenshot from bundle: " + err.Error())
+	}
+	stored, err := storeImage(dat)
+	if err != nil {
+		return "", err
+	}
+	if stored != h {
+		return "", errors.New("Screenshot " + h + " in the bundle is corrupt")
+	}
+	return stored, nil
+}
+
+// ImportBundle reads a bundle and adds its jam to the archive
+func (m *model) ImportBundle(r io.ReaderAt, size int64) (*ArchivedGamejam, error) {
+	a, err := ReadBundle(r, size)
+	if err != nil {
+		return nil, err
+	}
+	if a.UUID == m.jam.UUID {
+		return nil, errors.New(a.Name + " is the current jam")
+	}
+	if _, err = m.archive.GetArchivedJam(a.UUID); err == nil {
+		return nil, errors.New(a.Name + " is already in the archive")
+	}
+	if err = a.Save(m.storage); err != nil {
+		return nil, errors.New("Error saving imported jam: " + err.Error())
+	}
+	m.archive.Jams = append(m.archive.Jams, *a)
+	if err = m.SaveArchive(); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
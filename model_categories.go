@@ -0,0 +1,206 @@
+package main
+
+import (
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/pborman/uuid"
+)
+
+/**
+ * Category
+ * An award category that gets its own ranked ballot, e.g. "Best Art"
+ */
+type Category struct {
+	UUID      string
+	Name      string
+	CoinFlips []string // Team UUIDs in the order recorded coin flips placed them
+
+	mPath []string // The path in the DB to this category
+}
+
+// Create a category
+func NewCategory(id string) *Category {
+	if id == "" {
+		id = uuid.New()
+	}
+	return &Category{
+		UUID:  id,
+		mPath: []string{"jam", "categories", id},
+	}
+}
+
+// GetCategoryById returns the category with the given id
+func (gj *Gamejam) GetCategoryById(id string) (*Category, error) {
+	for i := range gj.Categories {
+		if gj.Categories[i].UUID == id {
+			return &gj.Categories[i], nil
+		}
+	}
+	return nil, errors.New("Invalid Category Id given")
+}
+
+// AddCategory adds an award category to the jam
+func (gj *Gamejam) AddCategory(cat *Category) error {
+	if strings.TrimSpace(cat.Name) == "" {
+		return errors.New("A category name is required")
+	}
+	for i := range gj.Categories {
+		if gj.Categories[i].UUID == cat.UUID {
+			return errors.New("A category with that ID already exists")
+		}
+		if gj.Categories[i].Name == cat.Name {
+			return errors.New("A category with that Name already exists")
+		}
+	}
+	gj.Categories = append(gj.Categories, *cat)
+	gj.IsChanged = true
+	return nil
+}
+
+// RemoveCategoryById removes an award category from the jam
+// Ballots already cast for it are kept on the votes
+func (gj *Gamejam) RemoveCategoryById(id string) error {
+	idx := -1
+	for i := range gj.Categories {
+		if gj.Categories[i].UUID == id {
+			idx = i
+			break
+		}
+	}
+	if idx == -1 {
+		return errors.New("Invalid Category ID given")
+	}
+	gj.Categories = append(gj.Categories[:idx], gj.Categories[idx+1:]...)
+	gj.IsChanged = true
+	return nil
+}
+
+// GetCategoryResults tallies the category ballots of the jam's votes
+func (gj *Gamejam) GetCategoryResults(cat *Category) []Ranking {
+	votes := votesForCategory(gj.Votes, cat.UUID)
+	res := getTallyMethod(gj.TallyMethod).Tally(gj.Teams, votes)
+	return applyTieBreaks(res, gj.Teams, votes, gj.TieBreaks, cat.CoinFlips)
+}
+
+// RecordCoinFlip records the order that a coin flip put the given teams in
+// for this category, replacing any earlier result for those teams
+func (cat *Category) RecordCoinFlip(order []string) {
+	cat.CoinFlips = mergeCoinFlip(cat.CoinFlips, order)
+}
+
+// votesForCategory returns a copy of each vote that cast a ballot in the
+// category, with that ballot as its choices
+func votesForCategory(votes []Vote, catId string) []Vote {
+	var ret []Vote
+	for _, v := range votes {
+		if chcs := v.CategoryChoices[catId]; len(chcs) > 0 {
+			cv := v
+			cv.Choices = chcs
+			cv.CategoryChoices = nil
+			ret = append(ret, cv)
+		}
+	}
+	return ret
+}
+
+/**
+ * DB Functions
+ * These are generally just called when the app starts up, or when the categories change
+ */
+
+// LoadAllCategories loads the jam's award categories from the database
+func (gj *Gamejam) LoadAllCategories() []Category {
+	var err error
+	var ret []Category
+	if err = gj.m.openDB(); err != nil {
+		return ret
+	}
+	defer gj.m.closeDB()
+
+	var catIds []string
+	if catIds, err = gj.m.db.GetBucketList(append(gj.mPath, "categories")); err != nil {
+		return ret
+	}
+	order := make(map[string]int)
+	for _, v := range catIds {
+		cat := NewCategory(v)
+		if cat.Name, err = gj.m.db.GetValue(cat.mPath, "name"); err != nil || cat.Name == "" {
+			continue
+		}
+		if flips, _ := gj.m.db.GetValue(cat.mPath, "coinflips"); flips != "" {
+			cat.CoinFlips = strings.Split(flips, ",")
+		}
+		order[cat.UUID], _ = gj.m.db.GetInt(cat.mPath, "order")
+		ret = append(ret, *cat)
+	}
+	sort.SliceStable(ret, func(i, j int) bool {
+		return order[ret[i].UUID] < order[ret[j].UUID]
+	})
+	return ret
+}
+
+// SaveCategories saves the jam's award categories to the database
+// and removes any categories from the database that aren't in the jam
+func (gj *Gamejam) SaveCategories() error {
+	var err error
+	if err = gj.m.openDB(); err != nil {
+		return err
+	}
+	defer gj.m.closeDB()
+
+	catsPath := append(gj.mPath, "categories")
+	if err = gj.m.db.MkBucketPath(catsPath); err != nil {
+		return err
+	}
+	for i, cat := range gj.Categories {
+		if err = gj.m.db.SetValue(cat.mPath, "name", cat.Name); err != nil {
+			return err
+		}
+		if err = gj.m.db.SetInt(cat.mPath, "order", i); err != nil {
+			return err
+		}
+		if err = gj.m.db.SetValue(cat.mPath, "coinflips", strings.Join(cat.CoinFlips, ",")); err != nil {
+			return err
+		}
+	}
+	var catIds []string
+	if catIds, err = gj.m.db.GetBucketList(catsPath); err != nil {
+		return err
+	}
+	for _, v := range catIds {
+		if _, err = gj.GetCategoryById(v); err != nil {
+			if err = gj.m.db.DeleteBucket(catsPath, v); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+/**
+ * Archived Categories
+ */
+
+// ArchivedCategory is a category of an archived jam along with its final results
+type ArchivedCategory struct {
+	Category
+	Rankings      []string
+	RankPlaces    []int
+	RankTieBreaks []string
+}
+
+// flattenRankings flattens rankings into the team UUIDs, places and tie-breaks
+func flattenRankings(rankings []Ranking) ([]string, []int, []string) {
+	var uuids, tiebreaks []string
+	var places []int
+	for _, v := range rankings {
+		for _, tv := range v.Teams {
+			uuids = append(uuids, tv.UUID)
+			places = append(places, v.Rank)
+			tiebreaks = append(tiebreaks, v.TieBreak)
+		}
+	}
+	return uuids, places, tiebreaks
+}
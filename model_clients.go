@@ -9,12 +9,14 @@ import (
 /**
  * Client
  * A client is a system that is connecting to the web server
+ * Clients are known by the ID in their device cookie, not by their IP. Behind
+ * NAT or a proxy, several clients can share an IP.
  */
 type Client struct {
 	UUID string
 	Auth bool
 	Name string
-	IP   string
+	IP   string // The last IP the client was seen at, just for reference
 
 	mPath []string // The path in the DB to this client
 }
@@ -34,21 +36,20 @@ func (m *model) AddClient(cl *Client) error {
 		if m.clients[i].UUID == cl.UUID {
 			return errors.New("A client with that ID already exists")
 		}
-		if m.clients[i].IP == cl.IP {
-			return errors.New("A client with that IP already exists")
-		}
-		if m.clients[i].Name == cl.Name {
+		if cl.Name != "" && m.clients[i].Name == cl.Name {
 			return errors.New("A client with that Name already exists")
 		}
 	}
+	if err := m.SaveClient(cl); err != nil {
+		return errors.New("Error saving client: " + err.Error())
+	}
 	m.clients = append(m.clients, *cl)
-	m.clientsUpdated = true
 	return nil
 }
 
 /**
  * DB Functions
- * These are generally just called when the app starts up, or when the periodic 'save' runs
+ * Clients are loaded when the app starts up, and saved as soon as they change
  */
 
 // Load all clients from the DB
@@ -62,7 +63,7 @@ func (m *model) LoadAllClients() []Client {
 
 	var clientUids []string
 	cliPath := []string{"clients"}
-	if clientUids, err = m.bolt.GetBucketList(cliPath); err != nil {
+	if clientUids, err = m.db.GetBucketList(cliPath); err != nil {
 		return ret
 	}
 	for _, v := range clientUids {
@@ -82,9 +83,9 @@ func (m *model) LoadClient(clId string) *Client {
 	defer m.closeDB()
 
 	cl := NewClient(clId)
-	cl.Auth, _ = m.bolt.GetBool(cl.mPath, "auth")
-	cl.Name, _ = m.bolt.GetValue(cl.mPath, "name")
-	cl.IP, _ = m.bolt.GetValue(cl.mPath, "ip")
+	cl.Auth, _ = m.db.GetBool(cl.mPath, "auth")
+	cl.Name, _ = m.db.GetValue(cl.mPath, "name")
+	cl.IP, _ = m.db.GetValue(cl.mPath, "ip")
 	return cl
 }
 
@@ -112,13 +113,13 @@ func (m *model) SaveClient(cl *Client) error {
 	}
 	defer m.closeDB()
 
-	if err = m.bolt.SetBool(cl.mPath, "auth", cl.Auth); err != nil {
+	if err = m.db.SetBool(cl.mPath, "auth", cl.Auth); err != nil {
 		return err
 	}
-	if err = m.bolt.SetValue(cl.mPath, "name", cl.Name); err != nil {
+	if err = m.db.SetValue(cl.mPath, "name", cl.Name); err != nil {
 		return err
 	}
-	return m.bolt.SetValue(cl.mPath, "ip", cl.IP)
+	return m.db.SetValue(cl.mPath, "ip", cl.IP)
 }
 
 /**
@@ -136,18 +137,11 @@ func (m *model) GetClient(id string) (*Client, error) {
 	return nil, errors.New("Invalid Id")
 }
 
-// Return a client by it's IP address
-func (m *model) GetClientByIp(ip string) (*Client, error) {
-	for i := range m.clients {
-		if m.clients[i].IP == ip {
-			return &m.clients[i], nil
-		}
+// Add/Update a client in the data model and the DB
+func (m *model) UpdateClient(cl *Client) error {
+	if err := m.SaveClient(cl); err != nil {
+		return errors.New("Error saving client: " + err.Error())
 	}
-	return nil, errors.New("Invalid Ip")
-}
-
-// Add/Update a client in the data model
-func (m *model) UpdateClient(cl *Client) {
 	var found bool
 	for i := range m.clients {
 		if m.clients[i].UUID == cl.UUID {
@@ -160,7 +154,7 @@ func (m *model) UpdateClient(cl *Client) {
 	if !found {
 		m.clients = append(m.clients, *cl)
 	}
-	m.clientsUpdated = true
+	return nil
 }
 
 func (m *model) DeleteClient(id string) error {
@@ -180,5 +174,5 @@ func (m *model) DeleteClient(id string) error {
 		return nil
 	}
 	defer m.closeDB()
-	return m.bolt.DeleteBucket([]string{"clients"}, id)
+	return m.db.DeleteBucket([]string{"clients"}, id)
 }
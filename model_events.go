@@ -0,0 +1,115 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+)
+
+/**
+ * Live Events
+ * Pages that show the current state of the jam (the admin main page and
+ * the stage display) subscribe to these so that they update as soon as
+ * something changes.
+ */
+
+// Event kinds: What changed to trigger an event
+const (
+	EventStatus = "status" // Sent to a subscriber when it connects
+	EventVote   = "vote"   // A vote was added
+	EventTeam   = "team"   // A team or its game changed
+	EventMode   = "mode"   // The public mode changed
+)
+
+// liveEvent is what subscribers are sent, a snapshot of the jam
+type liveEvent struct {
+	Event       string       `json:"event"`
+	Mode        int          `json:"mode"`
+	Votes       int          `json:"vote_count"`
+	Teams       int          `json:"team_count"`
+	TallyMethod string       `json:"tally_method"`
+	Results     []apiRanking `json:"results"`
+}
+
+// eventBroker hands every published event to all of its subscribers
+type eventBroker struct {
+	mu   sync.Mutex
+	subs map[chan []byte]bool
+}
+
+// How many events a subscriber can fall behind before it starts missing them
+const eventBufferSize = 16
+
+func newEventBroker() *eventBroker {
+	return &eventBroker{subs: make(map[chan []byte]bool)}
+}
+
+// Subscribe returns a channel that receives every event published from now on
+func (b *eventBroker) Subscribe() chan []byte {
+	ch := make(chan []byte, eventBufferSize)
+	b.mu.Lock()
+	b.subs[ch] = true
+	b.mu.Unlock()
+	return ch
+}
+
+// Unsubscribe stops sending events to ch and closes it
+func (b *eventBroker) Unsubscribe(ch chan []byte) {
+	b.mu.Lock()
+	if b.subs[ch] {
+		delete(b.subs, ch)
+		close(ch)
+	}
+	b.mu.Unlock()
+}
+
+// SubscriberCount returns how many subscribers there currently are
+func (b *eventBroker) SubscriberCount() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.subs)
+}
+
+// Publish sends msg to every subscriber
+// A subscriber that isn't keeping up misses the event rather than holding
+// up everyone else, every event is a full snapshot so it'll catch up on the
+// next one.
+func (b *eventBroker) Publish(msg []byte) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for ch := range b.subs {
+		select {
+		case ch <- msg:
+		default:
+		}
+	}
+}
+
+// liveStatus builds the snapshot of the jam that gets sent to subscribers
+func (m *model) liveStatus(event string) liveEvent {
+	return liveEvent{
+		Event:       event,
+		Mode:        m.site.GetPublicMode(),
+		Votes:       len(m.jam.Votes),
+		Teams:       len(m.jam.Teams),
+		TallyMethod: m.jam.GetTallyMethodName(),
+		Results:     newApiRankings(m.jam.GetResults()),
+	}
+}
+
+// publishEvent lets all subscribers know that something changed
+func (m *model) publishEvent(event string) {
+	if m == nil || m.events == nil || m.jam == nil || m.site == nil {
+		return
+	}
+	if m.events.SubscriberCount() == 0 {
+		// Don't bother tallying the votes if no one is listening
+		return
+	}
+	msg, err := json.Marshal(m.liveStatus(event))
+	if err != nil {
+		fmt.Println("Error encoding live event: " + err.Error())
+		return
+	}
+	m.events.Publish(msg)
+}
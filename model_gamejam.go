@@ -12,11 +12,23 @@ import (
  * Gamejam is the struct for any gamejam (current or archived)
  */
 type Gamejam struct {
-	UUID  string
-	Name  string
-	Date  time.Time
-	Teams []Team
-	Votes []Vote
+	UUID        string
+	Name        string
+	Date        time.Time
+	TallyMethod string   // Key of the TallyMethod used to compute results
+	TieBreaks   []string // Keys of the TieBreakers used to settle ties, in order
+	CoinFlips   []string // Team UUIDs in the order recorded coin flips placed them
+	Categories  []Category
+	// Criteria that judges score each game on, and the percent of the
+	// combined standing that comes from judge scores
+	JudgeCriteria []string
+	JudgeWeight   int
+	JudgeScores   []JudgeScore
+	// When voting opens and closes, zero if not scheduled
+	VotingOpens  time.Time
+	VotingCloses time.Time
+	Teams        []Team
+	Votes        []Vote
 
 	m     *model   // The model that holds this gamejam's data
 	mPath []string // The path in the db to this gamejam
@@ -27,6 +39,9 @@ type Gamejam struct {
 func NewGamejam(m *model) *Gamejam {
 	gj := new(Gamejam)
 	gj.Name = time.Now().Format("2006-01-02T15:04:05 Game Jam")
+	gj.TallyMethod = TallyCondorcet
+	gj.TieBreaks = append([]string{}, defaultTieBreaks...)
+	gj.JudgeWeight = 50
 	gj.m = m
 	gj.mPath = []string{"jam"}
 	return gj
@@ -34,7 +49,8 @@ func NewGamejam(m *model) *Gamejam {
 
 /**
  * DB Functions
- * These are generally just called when the app starts up, or when the periodic 'save' runs
+ * The jam is loaded when the app starts up, and its parts are saved as soon
+ * as they change. SaveToDB saves everything, which is done at shutdown.
  */
 
 func (m *model) LoadCurrentJam() (*Gamejam, error) {
@@ -44,7 +60,43 @@ func (m *model) LoadCurrentJam() (*Gamejam, error) {
 	defer m.closeDB()
 
 	gj := NewGamejam(m)
-	gj.Name, _ = m.bolt.GetValue(gj.mPath, "name")
+	// A jam only has a UUID and date if it was restored from the archive
+	gj.UUID, _ = m.db.GetValue(gj.mPath, "uuid")
+	gj.Name, _ = m.db.GetValue(gj.mPath, "name")
+	if dt, _ := m.db.GetValue(gj.mPath, "date"); dt != "" {
+		gj.Date, _ = time.Parse(time.RFC3339, dt)
+	}
+	if tally, _ := m.db.GetValue(gj.mPath, "tallymethod"); isValidTallyMethod(tally) {
+		gj.TallyMethod = tally
+	}
+	if keys, err := m.db.GetKeyList(gj.mPath); err == nil {
+		for _, k := range keys {
+			if k == "tiebreaks" {
+				// Only replace the default chain if one has been saved
+				tbs, _ := m.db.GetValue(gj.mPath, "tiebreaks")
+				gj.TieBreaks = cleanTieBreaks(strings.Split(tbs, ","))
+			}
+		}
+	}
+	if flips, _ := m.db.GetValue(gj.mPath, "coinflips"); flips != "" {
+		gj.CoinFlips = strings.Split(flips, ",")
+	}
+
+	if criteria, _ := m.db.GetValue(gj.mPath, "judgecriteria"); criteria != "" {
+		gj.setJudgeCriteria(criteria)
+	}
+	if weight, err := m.db.GetInt(gj.mPath, "judgeweight"); err == nil {
+		gj.JudgeWeight = weight
+	}
+	if opens, _ := m.db.GetValue(gj.mPath, "votingopens"); opens != "" {
+		gj.VotingOpens, _ = time.Parse(time.RFC3339, opens)
+	}
+	if closes, _ := m.db.GetValue(gj.mPath, "votingcloses"); closes != "" {
+		gj.VotingCloses, _ = time.Parse(time.RFC3339, closes)
+	}
+
+	// Load all award categories
+	gj.Categories = gj.LoadAllCategories()
 
 	// Load all teams
 	gj.Teams = gj.LoadAllTeams()
@@ -52,6 +104,9 @@ func (m *model) LoadCurrentJam() (*Gamejam, error) {
 	// Load all votes
 	gj.Votes = gj.LoadAllVotes()
 
+	// Load all judge scores
+	gj.JudgeScores = gj.LoadAllJudgeScores()
+
 	return gj, nil
 }
 
@@ -63,10 +118,17 @@ func (gj *Gamejam) SaveToDB() error {
 	defer gj.m.closeDB()
 
 	var errs []error
-	if err := gj.m.bolt.SetValue(gj.mPath, "name", gj.Name); err != nil {
+	if err := gj.SaveSettings(); err != nil {
+		errs = append(errs, err)
+	}
+	// Save all Categories
+	if err := gj.SaveCategories(); err != nil {
+		errs = append(errs, err)
+	}
+	// Save all Teams, and drop any that were removed
+	if err := gj.deleteStaleTeams(); err != nil {
 		errs = append(errs, err)
 	}
-	// Save all Teams
 	for _, tm := range gj.Teams {
 		fmt.Println("Saving Team " + tm.Name + " data to DB")
 		if err := gj.SaveTeam(&tm); err != nil {
@@ -80,6 +142,10 @@ func (gj *Gamejam) SaveToDB() error {
 			errs = append(errs, err)
 		}
 	}
+	// Save all Judge Scores
+	if err := gj.SaveJudgeScores(); err != nil {
+		errs = append(errs, err)
+	}
 	if len(errs) > 0 {
 		var errTxt string
 		for i := range errs {
@@ -90,3 +156,46 @@ func (gj *Gamejam) SaveToDB() error {
 	}
 	return nil
 }
+
+// SaveSettings saves the jam's own settings to the DB
+// Its categories, teams, votes and judge scores are saved on their own
+func (gj *Gamejam) SaveSettings() error {
+	var err error
+	if err = gj.m.openDB(); err != nil {
+		return err
+	}
+	defer gj.m.closeDB()
+
+	if err = gj.m.db.SetValue(gj.mPath, "uuid", gj.UUID); err != nil {
+		return err
+	}
+	if err = gj.m.db.SetValue(gj.mPath, "name", gj.Name); err != nil {
+		return err
+	}
+	var dt string
+	if !gj.Date.IsZero() {
+		dt = gj.Date.Format(time.RFC3339)
+	}
+	if err = gj.m.db.SetValue(gj.mPath, "date", dt); err != nil {
+		return err
+	}
+	if err = gj.m.db.SetValue(gj.mPath, "tallymethod", gj.TallyMethod); err != nil {
+		return err
+	}
+	if err = gj.m.db.SetValue(gj.mPath, "tiebreaks", strings.Join(gj.TieBreaks, ",")); err != nil {
+		return err
+	}
+	if err = gj.m.db.SetValue(gj.mPath, "coinflips", strings.Join(gj.CoinFlips, ",")); err != nil {
+		return err
+	}
+	if err = gj.m.db.SetValue(gj.mPath, "judgecriteria", strings.Join(gj.JudgeCriteria, ",")); err != nil {
+		return err
+	}
+	if err = gj.m.db.SetInt(gj.mPath, "judgeweight", gj.JudgeWeight); err != nil {
+		return err
+	}
+	if err = gj.m.db.SetValue(gj.mPath, "votingopens", formatWindowTime(gj.VotingOpens)); err != nil {
+		return err
+	}
+	return gj.m.db.SetValue(gj.mPath, "votingcloses", formatWindowTime(gj.VotingCloses))
+}
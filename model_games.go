@@ -3,6 +3,9 @@ package main
 import (
 	"errors"
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/pborman/uuid"
 )
@@ -58,11 +61,12 @@ func (gm *Game) RemoveScreenshot(ssId string) error {
 }
 
 type Screenshot struct {
-	UUID        string
-	Description string
-	Image       string
-	Thumbnail   string
-	Filetype    string
+	UUID          string
+	Description   string
+	ImageHash     string         // The image file in the screenshot store
+	ThumbnailHash string         // The default (smallest) thumbnail
+	Thumbnails    map[int]string // Thumbnail files by width
+	Filetype      string         // The image's format: png, jpeg, gif or webp
 
 	mPath []string // The path in the DB to this screenshot
 }
@@ -82,9 +86,48 @@ func NewScreenshot(tmId, ssId string) (*Screenshot, error) {
 	}, nil
 }
 
+// ThumbnailFor returns the hash of the smallest thumbnail that's at least
+// width pixels wide, or the widest one there is
+func (ss *Screenshot) ThumbnailFor(width int) string {
+	if width <= 0 || len(ss.Thumbnails) == 0 {
+		return ss.ThumbnailHash
+	}
+	best, bestSz := ss.ThumbnailHash, 0
+	for sz, h := range ss.Thumbnails {
+		switch {
+		case bestSz < width && sz > bestSz:
+			// Still looking for one that's wide enough
+			best, bestSz = h, sz
+		case sz >= width && sz < bestSz:
+			// Wide enough, and smaller than what we had
+			best, bestSz = h, sz
+		}
+	}
+	return best
+}
+
+// ThumbnailSrcset returns a srcset for the screenshot's thumbnails
+func (ss *Screenshot) ThumbnailSrcset() string {
+	var sizes []int
+	for sz := range ss.Thumbnails {
+		sizes = append(sizes, sz)
+	}
+	sort.Ints(sizes)
+	var ret []string
+	for _, sz := range sizes {
+		ret = append(ret, ss.thumbnailURL()+"?w="+strconv.Itoa(sz)+" "+strconv.Itoa(sz)+"w")
+	}
+	return strings.Join(ret, ", ")
+}
+
+func (ss *Screenshot) thumbnailURL() string {
+	// mPath is jam/teams/<team id>/game/screenshots/<id>
+	return "/thumbnail/" + ss.mPath[2] + "/" + ss.UUID
+}
+
 /**
  * DB Functions
- * These are generally just called when the app starts up, or when the periodic 'save' runs
+ * Games are loaded when the app starts up, and saved as soon as they change
  */
 
 // Load a team's game from the DB and return it
@@ -99,16 +142,16 @@ func (gj *Gamejam) LoadTeamGame(tmId string) (*Game, error) {
 	if err != nil {
 		return nil, err
 	}
-	if gm.Name, err = gj.m.bolt.GetValue(gm.mPath, "name"); err != nil {
+	if gm.Name, err = gj.m.db.GetValue(gm.mPath, "name"); err != nil {
 		gm.Name = ""
 	}
-	if gm.Description, err = gj.m.bolt.GetValue(gm.mPath, "description"); err != nil {
+	if gm.Description, err = gj.m.db.GetValue(gm.mPath, "description"); err != nil {
 		gm.Description = ""
 	}
-	if gm.Link, err = gj.m.bolt.GetValue(gm.mPath, "link"); err != nil {
+	if gm.Link, err = gj.m.db.GetValue(gm.mPath, "link"); err != nil {
 		gm.Link = ""
 	}
-	if gm.Framework, err = gj.m.bolt.GetValue(gm.mPath, "framework"); err != nil {
+	if gm.Framework, err = gj.m.db.GetValue(gm.mPath, "framework"); err != nil {
 		gm.Framework = ""
 	}
 
@@ -133,7 +176,7 @@ func (gj *Gamejam) LoadTeamGameScreenshots(tmId string) []Screenshot {
 	}
 	ssBktPath := append(gm.mPath, "screenshots")
 	var ssIds []string
-	ssIds, _ = gj.m.bolt.GetBucketList(ssBktPath)
+	ssIds, _ = gj.m.db.GetBucketList(ssBktPath)
 	for _, v := range ssIds {
 		ssLd, _ := gj.LoadTeamGameScreenshot(tmId, v)
 		if ssLd != nil {
@@ -155,25 +198,37 @@ func (gj *Gamejam) LoadTeamGameScreenshot(tmId, ssId string) (*Screenshot, error
 	if err != nil {
 		return nil, err
 	}
-	if ret.Description, err = gj.m.bolt.GetValue(ret.mPath, "description"); err != nil {
+	if ret.Description, err = gj.m.db.GetValue(ret.mPath, "description"); err != nil {
 		return nil, err
 	}
-	if ret.Image, err = gj.m.bolt.GetValue(ret.mPath, "image"); err != nil {
+	if ret.ImageHash, err = gj.m.db.GetValue(ret.mPath, "imagehash"); err != nil {
 		return nil, err
 	}
-	if ret.Thumbnail, err = gj.m.bolt.GetValue(ret.mPath, "thumbnail"); err != nil {
+	if ret.ThumbnailHash, err = gj.m.db.GetValue(ret.mPath, "thumbnailhash"); err != nil {
 		return nil, err
 	}
-	if ret.Thumbnail == "" {
-		ret.Thumbnail = ret.Image
+	if ret.ThumbnailHash == "" {
+		ret.ThumbnailHash = ret.ImageHash
 	}
-	if ret.Filetype, err = gj.m.bolt.GetValue(ret.mPath, "filetype"); err != nil {
+	ret.Thumbnails = make(map[int]string)
+	var szs []string
+	szs, _ = gj.m.db.GetKeyList(append(ret.mPath, "thumbnails"))
+	for _, v := range szs {
+		sz, err := strconv.Atoi(v)
+		if err != nil {
+			continue
+		}
+		if h, _ := gj.m.db.GetValue(append(ret.mPath, "thumbnails"), v); h != "" {
+			ret.Thumbnails[sz] = h
+		}
+	}
+	if ret.Filetype, err = gj.m.db.GetValue(ret.mPath, "filetype"); err != nil {
 		return nil, err
 	}
 	return ret, err
 }
 
-// Save a game to the DB
+// Save a game to the DB, in one transaction
 func (gj *Gamejam) SaveGame(gm *Game) error {
 	var err error
 	if err = gj.m.openDB(); err != nil {
@@ -181,7 +236,15 @@ func (gj *Gamejam) SaveGame(gm *Game) error {
 	}
 	defer gj.m.closeDB()
 
-	if err := gj.m.bolt.MkBucketPath(gm.mPath); err != nil {
+	return gj.m.db.Update(func(tx Store) error {
+		return gj.saveGame(tx, gm)
+	})
+}
+
+// saveGame writes gm to tx
+func (gj *Gamejam) saveGame(tx Store, gm *Game) error {
+	var err error
+	if err = tx.MkBucketPath(gm.mPath); err != nil {
 		return err
 	}
 
@@ -192,22 +255,22 @@ func (gj *Gamejam) SaveGame(gm *Game) error {
 	if gm.Name == "" {
 		gm.Name = tm.Name + "'s Game"
 	}
-	if err := gj.m.bolt.SetValue(gm.mPath, "name", gm.Name); err != nil {
+	if err = tx.SetValue(gm.mPath, "name", gm.Name); err != nil {
 		return err
 	}
-	if err := gj.m.bolt.SetValue(gm.mPath, "link", gm.Link); err != nil {
+	if err = tx.SetValue(gm.mPath, "link", gm.Link); err != nil {
 		return err
 	}
-	if err := gj.m.bolt.SetValue(gm.mPath, "description", gm.Description); err != nil {
+	if err = tx.SetValue(gm.mPath, "description", gm.Description); err != nil {
 		return err
 	}
-	if err := gj.m.bolt.SetValue(gm.mPath, "framework", gm.Framework); err != nil {
+	if err = tx.SetValue(gm.mPath, "framework", gm.Framework); err != nil {
 		return err
 	}
-	if err := gj.m.bolt.MkBucketPath(append(gm.mPath, "screenshots")); err != nil {
+	if err = tx.MkBucketPath(append(gm.mPath, "screenshots")); err != nil {
 		return err
 	}
-	return gj.SaveScreenshots(gm)
+	return gj.saveScreenshots(tx, gm)
 }
 
 // Save all of the game's screenshots to the DB
@@ -219,15 +282,22 @@ func (gj *Gamejam) SaveScreenshots(gm *Game) error {
 	}
 	defer gj.m.closeDB()
 
+	return gj.m.db.Update(func(tx Store) error {
+		return gj.saveScreenshots(tx, gm)
+	})
+}
+
+func (gj *Gamejam) saveScreenshots(tx Store, gm *Game) error {
+	var err error
 	for _, ss := range gm.Screenshots {
-		if err = gj.SaveScreenshot(&ss); err != nil {
+		if err = saveScreenshot(tx, &ss); err != nil {
 			return err
 		}
 	}
 	// Now remove unused screenshots
 	ssPath := append(gm.mPath, "screenshots")
 	var ssIds []string
-	if ssIds, err = gj.m.bolt.GetBucketList(ssPath); err != nil {
+	if ssIds, err = tx.GetBucketList(ssPath); err != nil {
 		return err
 	}
 	for i := range ssIds {
@@ -240,7 +310,7 @@ func (gj *Gamejam) SaveScreenshots(gm *Game) error {
 			// Error building screenshot to delete...
 			continue
 		}
-		if err = gj.DeleteScreenshot(ss); err != nil {
+		if err = deleteScreenshot(tx, ss); err != nil {
 			return err
 		}
 	}
@@ -255,16 +325,31 @@ func (gj *Gamejam) SaveScreenshot(ss *Screenshot) error {
 	}
 	defer gj.m.closeDB()
 
-	if err = gj.m.bolt.MkBucketPath(ss.mPath); err != nil {
+	return gj.m.db.Update(func(tx Store) error {
+		return saveScreenshot(tx, ss)
+	})
+}
+
+func saveScreenshot(tx Store, ss *Screenshot) error {
+	var err error
+	if err = tx.MkBucketPath(ss.mPath); err != nil {
 		return err
 	}
-	if err = gj.m.bolt.SetValue(ss.mPath, "description", ss.Description); err != nil {
+	if err = tx.SetValue(ss.mPath, "description", ss.Description); err != nil {
 		return err
 	}
-	if err = gj.m.bolt.SetValue(ss.mPath, "image", ss.Image); err != nil {
+	if err = tx.SetValue(ss.mPath, "imagehash", ss.ImageHash); err != nil {
 		return err
 	}
-	if err = gj.m.bolt.SetValue(ss.mPath, "filetype", ss.Filetype); err != nil {
+	if err = tx.SetValue(ss.mPath, "thumbnailhash", ss.ThumbnailHash); err != nil {
+		return err
+	}
+	for sz, h := range ss.Thumbnails {
+		if err = tx.SetValue(append(ss.mPath, "thumbnails"), strconv.Itoa(sz), h); err != nil {
+			return err
+		}
+	}
+	if err = tx.SetValue(ss.mPath, "filetype", ss.Filetype); err != nil {
 		return err
 	}
 	return nil
@@ -278,8 +363,12 @@ func (gj *Gamejam) DeleteScreenshot(ss *Screenshot) error {
 	}
 	defer gj.m.closeDB()
 
+	return deleteScreenshot(gj.m.db, ss)
+}
+
+func deleteScreenshot(tx Store, ss *Screenshot) error {
 	ssPath := ss.mPath[:len(ss.mPath)-1]
-	return gj.m.bolt.DeleteBucket(ssPath, ss.UUID)
+	return tx.DeleteBucket(ssPath, ss.UUID)
 }
 
 /**
@@ -293,7 +382,10 @@ func (gj *Gamejam) UpdateGame(tmId string, gm *Game) error {
 	if err != nil {
 		return errors.New("Error getting team: " + err.Error())
 	}
+	if err = gj.SaveGame(gm); err != nil {
+		return errors.New("Error saving game: " + err.Error())
+	}
 	tm.Game = gm
-	gj.IsChanged = true
+	gj.m.publishEvent(EventTeam)
 	return nil
 }
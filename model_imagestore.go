@@ -0,0 +1,276 @@
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"image"
+	_ "image/gif" // Registers the GIF decoder
+	"image/jpeg"
+	"image/png"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/nfnt/resize"
+	_ "golang.org/x/image/webp" // Registers the WebP decoder
+)
+
+/**
+ * Screenshot Store
+ * Screenshot images are kept as files under DataDir/screenshots rather
+ * than in the DB. Files are named by the SHA-256 of their contents, so an
+ * image that's uploaded twice is only stored once, and a file never changes
+ * once it's written. Archived jams refer to the same files, so they aren't
+ * removed when a screenshot is deleted from the current jam.
+ */
+
+// The directory that screenshot images are stored in
+const ScreenshotDir = DataDir + "/screenshots"
+
+// validImageHash returns whether h looks like a hash from storeImage
+// Anything else could be used to build a path outside of ScreenshotDir
+func validImageHash(h string) bool {
+	if len(h) != sha256.Size*2 {
+		return false
+	}
+	_, err := hex.DecodeString(h)
+	return err == nil
+}
+
+// imagePath returns the path to the file for the image with hash h
+// Files are split into subdirectories by the first two characters of the hash
+// so that no one directory gets too big
+func imagePath(h string) string {
+	return filepath.Join(ScreenshotDir, h[:2], h)
+}
+
+// storeImage writes dat to the screenshot store and returns its hash
+func storeImage(dat []byte) (string, error) {
+	sum := sha256.Sum256(dat)
+	h := hex.EncodeToString(sum[:])
+	pth := imagePath(h)
+	if _, err := os.Stat(pth); err == nil {
+		// We already have it
+		return h, nil
+	}
+	if err := os.MkdirAll(filepath.Dir(pth), os.ModePerm); err != nil {
+		return "", errors.New("Unable to create screenshot directory: " + err.Error())
+	}
+	// Write to a temp file first, so that a half written file never shows up
+	// under the hash
+	tmp, err := ioutil.TempFile(filepath.Dir(pth), h+".tmp")
+	if err != nil {
+		return "", errors.New("Unable to create screenshot file: " + err.Error())
+	}
+	if _, err = tmp.Write(dat); err == nil {
+		err = tmp.Sync()
+	}
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), pth)
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return "", errors.New("Unable to write screenshot file: " + err.Error())
+	}
+	return h, nil
+}
+
+// openImage opens the file for the image with hash h
+func openImage(h string) (*os.File, error) {
+	if !validImageHash(h) {
+		return nil, errors.New("Invalid image hash")
+	}
+	return os.Open(imagePath(h))
+}
+
+// migrateScreenshots moves any screenshots that are still stored in the DB as
+// base64 out to the screenshot store, and returns how many it moved
+// bolt should be open
+func migrateScreenshots(bolt Store) (int, error) {
+	var moved int
+	tmIds, err := bolt.GetBucketList([]string{"jam", "teams"})
+	if err != nil {
+		// No teams, nothing to move
+		return 0, nil
+	}
+	for _, tmId := range tmIds {
+		ssPath := []string{"jam", "teams", tmId, "game", "screenshots"}
+		ssIds, err := bolt.GetBucketList(ssPath)
+		if err != nil {
+			continue
+		}
+		for _, ssId := range ssIds {
+			pth := append(ssPath, ssId)
+			img, _ := bolt.GetValue(pth, "image")
+			if img == "" {
+				continue
+			}
+			imgHash, err := storeBase64Image(img)
+			if err != nil {
+				return moved, errors.New("Error moving screenshot " + ssId + ": " + err.Error())
+			}
+			thmHash := imgHash
+			if thm, _ := bolt.GetValue(pth, "thumbnail"); thm != "" {
+				if thmHash, err = storeBase64Image(thm); err != nil {
+					return moved, errors.New("Error moving thumbnail " + ssId + ": " + err.Error())
+				}
+			}
+			// The files are written, now the blobs can go
+			err = bolt.Update(func(tx Store) error {
+				if err := tx.SetValue(pth, "imagehash", imgHash); err != nil {
+					return err
+				}
+				if err := tx.SetValue(pth, "thumbnailhash", thmHash); err != nil {
+					return err
+				}
+				if err := tx.DeletePair(pth, "image"); err != nil {
+					return err
+				}
+				return tx.DeletePair(pth, "thumbnail")
+			})
+			if err != nil {
+				return moved, err
+			}
+			moved++
+		}
+	}
+	return moved, nil
+}
+
+func storeBase64Image(b64 string) (string, error) {
+	dat, err := base64.StdEncoding.DecodeString(b64)
+	if err != nil {
+		return "", err
+	}
+	return storeImage(dat)
+}
+
+/**
+ * Screenshot Uploads
+ * The uploaded file is stored as it is, so PNG transparency and GIF
+ * animation survive. Thumbnails are made at each of the site's thumbnail
+ * sizes, as PNGs if the image has any transparency and JPEGs if it doesn't.
+ * WebP uploads are kept as WebP, but their thumbnails are PNG or JPEG too,
+ * there's no WebP encoder to make WebP thumbnails with.
+ */
+
+// The formats screenshots can be uploaded in, by the name image.DecodeConfig gives them
+var screenshotFormats = map[string]bool{"png": true, "jpeg": true, "gif": true, "webp": true}
+
+// Screenshot size defaults
+const (
+	DefaultMaxUploadSize = 10 << 20 // Bytes
+	DefaultMaxImageSize  = 8192     // Pixels, width or height
+)
+
+// The thumbnail widths that are made by default
+var DefaultThumbnailSizes = []int{200, 600}
+
+// The widest thumbnail that can be asked for
+const maxThumbnailSize = 2000
+
+// parseThumbnailSizes parses a comma separated list of thumbnail widths
+// The widths are returned smallest first, without duplicates
+func parseThumbnailSizes(val string) ([]int, error) {
+	var ret []int
+	seen := make(map[int]bool)
+	for _, v := range strings.Split(val, ",") {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			continue
+		}
+		sz, err := strconv.Atoi(v)
+		if err != nil || sz <= 0 || sz > maxThumbnailSize {
+			return nil, errors.New("Invalid thumbnail size: " + v + " (Must be between 1 and " + strconv.Itoa(maxThumbnailSize) + ")")
+		}
+		if !seen[sz] {
+			seen[sz] = true
+			ret = append(ret, sz)
+		}
+	}
+	if len(ret) == 0 {
+		return nil, errors.New("At least one thumbnail size is required")
+	}
+	sort.Ints(ret)
+	return ret, nil
+}
+
+// processScreenshot checks that dat is an image we accept, stores it, and
+// makes its thumbnails
+func (m *model) processScreenshot(tmId string, dat []byte) (*Screenshot, error) {
+	if len(dat) > m.site.MaxUploadSize {
+		return nil, errors.New("Screenshots can't be bigger than " + formatBytes(m.site.MaxUploadSize))
+	}
+	// Check the dimensions before decoding the whole thing
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(dat))
+	if err != nil || !screenshotFormats[format] {
+		return nil, errors.New("Screenshots have to be PNG, JPEG, GIF or WebP images")
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 {
+		return nil, errors.New("The image is empty")
+	}
+	if cfg.Width > m.site.MaxImageSize || cfg.Height > m.site.MaxImageSize {
+		return nil, errors.New("Screenshots can't be more than " + strconv.Itoa(m.site.MaxImageSize) + " pixels wide or tall")
+	}
+	// For an animated GIF this is the first frame, which is what the thumbnails show
+	img, _, err := image.Decode(bytes.NewReader(dat))
+	if err != nil {
+		return nil, errors.New("Unable to read image: " + err.Error())
+	}
+
+	ss, err := NewScreenshot(tmId, "")
+	if err != nil {
+		return nil, err
+	}
+	ss.Filetype = format
+	if ss.ImageHash, err = storeImage(dat); err != nil {
+		return nil, err
+	}
+	opaque := false
+	if o, ok := img.(interface{ Opaque() bool }); ok {
+		opaque = o.Opaque()
+	}
+	ss.Thumbnails = make(map[int]string)
+	for _, sz := range m.site.ThumbnailSizes {
+		wd := sz
+		if wd > cfg.Width {
+			// Don't blow up small images
+			wd = cfg.Width
+		}
+		thm := resize.Resize(uint(wd), 0, img, resize.Lanczos3)
+		buf := new(bytes.Buffer)
+		if opaque {
+			err = jpeg.Encode(buf, thm, &jpeg.Options{Quality: 85})
+		} else {
+			err = png.Encode(buf, thm)
+		}
+		if err != nil {
+			return nil, errors.New("Unable to make thumbnail: " + err.Error())
+		}
+		if ss.Thumbnails[sz], err = storeImage(buf.Bytes()); err != nil {
+			return nil, err
+		}
+	}
+	ss.ThumbnailHash = ss.Thumbnails[m.site.ThumbnailSizes[0]]
+	return ss, nil
+}
+
+// formatBytes formats a size in bytes for people to read
+func formatBytes(n int) string {
+	switch {
+	case n >= 1<<20:
+		return strconv.FormatFloat(float64(n)/(1<<20), 'f', -1, 64) + "MB"
+	case n >= 1<<10:
+		return strconv.FormatFloat(float64(n)/(1<<10), 'f', -1, 64) + "KB"
+	}
+	return strconv.Itoa(n) + " bytes"
+}
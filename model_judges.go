@@ -0,0 +1,341 @@
+package main
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// These are all model functions that have to do with judges
+// Like admin users, we manipulate the DB directly so that we always
+// use the most up-to-date judge information.
+
+func (m *model) getAllJudges() []string {
+	if err := m.openDB(); err != nil {
+		return []string{}
+	}
+	defer m.closeDB()
+
+	jdgs, err := m.db.GetBucketList([]string{"judges"})
+	if err != nil {
+		return []string{}
+	}
+	return jdgs
+}
+
+// Is the given email one that is in our DB?
+func (m *model) isValidJudgeEmail(email string) bool {
+	if err := m.openDB(); err != nil {
+		return false
+	}
+	defer m.closeDB()
+
+	jdgPath := []string{"judges", email}
+	_, err := m.db.GetValue(jdgPath, "password")
+	return err == nil
+}
+
+// Is the email and pw given valid for a judge?
+func (m *model) checkJudgeCredentials(email, pw string) error {
+	var err error
+	if err = m.openDB(); err != nil {
+		return err
+	}
+	defer m.closeDB()
+
+	var jPw string
+	jdgPath := []string{"judges", email}
+	if jPw, err = m.db.GetValue(jdgPath, "password"); err != nil {
+		return err
+	}
+	return bcrypt.CompareHashAndPassword([]byte(jPw), []byte(pw))
+}
+
+// updateJudgePassword
+// Takes an email address and a password
+// Creates the judge if it doesn't exist, encrypts the password
+// and updates it in the db
+func (m *model) updateJudgePassword(email, password string) error {
+	cryptPw, cryptError := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if cryptError != nil {
+		return cryptError
+	}
+	if err := m.openDB(); err != nil {
+		return err
+	}
+	defer m.closeDB()
+
+	jdgPath := []string{"judges", email}
+	return m.db.SetValue(jdgPath, "password", string(cryptPw))
+}
+
+func (m *model) deleteJudge(email string) error {
+	var err error
+	if err = m.openDB(); err != nil {
+		return err
+	}
+	defer m.closeDB()
+
+	return m.db.DeleteBucket([]string{"judges"}, email)
+}
+
+/**
+ * JudgeScore
+ * The scores that one judge gave one game, for each of the jam's criteria
+ */
+type JudgeScore struct {
+	Judge  string         // Email of the judge
+	Team   string         // UUID of the team
+	Scores map[string]int // Criterion name to score, 1-10
+
+	mPath []string // The path in the DB to this score
+}
+
+// Judges score each criterion between these
+const (
+	JudgeScoreMin = 1
+	JudgeScoreMax = 10
+)
+
+// Create a judge score
+func NewJudgeScore(judge, tmId string) (*JudgeScore, error) {
+	if judge == "" {
+		return nil, errors.New("Judge is required")
+	}
+	if tmId == "" {
+		return nil, errors.New("Team ID is required")
+	}
+	return &JudgeScore{
+		Judge:  judge,
+		Team:   tmId,
+		Scores: make(map[string]int),
+		mPath:  []string{"jam", "judgescores", judge, tmId},
+	}, nil
+}
+
+// GetJudgeScore returns the scores that judge gave the team
+func (gj *Gamejam) GetJudgeScore(judge, tmId string) (*JudgeScore, error) {
+	for i := range gj.JudgeScores {
+		if gj.JudgeScores[i].Judge == judge && gj.JudgeScores[i].Team == tmId {
+			return &gj.JudgeScores[i], nil
+		}
+	}
+	return nil, errors.New("Couldn't find requested score")
+}
+
+// SetJudgeScore adds a judge's scores for a game, replacing any they gave it before
+func (gj *Gamejam) SetJudgeScore(js *JudgeScore) error {
+	if _, err := gj.GetTeamById(js.Team); err != nil {
+		return err
+	}
+	for _, c := range gj.JudgeCriteria {
+		sc, ok := js.Scores[c]
+		if !ok {
+			return errors.New("A score for " + c + " is required")
+		}
+		if sc < JudgeScoreMin || sc > JudgeScoreMax {
+			return errors.New("Scores for " + c + " must be between " + strconv.Itoa(JudgeScoreMin) + " and " + strconv.Itoa(JudgeScoreMax))
+		}
+	}
+	if err := gj.SaveJudgeScore(js); err != nil {
+		return errors.New("Error saving scores: " + err.Error())
+	}
+	if fnd, err := gj.GetJudgeScore(js.Judge, js.Team); err == nil {
+		*fnd = *js
+	} else {
+		gj.JudgeScores = append(gj.JudgeScores, *js)
+	}
+	return nil
+}
+
+// setJudgeCriteria sets the criteria from a comma separated list
+func (gj *Gamejam) setJudgeCriteria(list string) {
+	gj.JudgeCriteria = cleanJudgeCriteria(strings.Split(list, ","))
+}
+
+// cleanJudgeCriteria trims the criteria, dropping blanks and repeats
+func cleanJudgeCriteria(criteria []string) []string {
+	var ret []string
+	seen := make(map[string]bool)
+	for _, v := range criteria {
+		v = strings.TrimSpace(v)
+		if v != "" && !seen[v] {
+			seen[v] = true
+			ret = append(ret, v)
+		}
+	}
+	return ret
+}
+
+/**
+ * Combined Standings
+ * The public result merged with the judges' scores
+ */
+
+// CombinedStanding is where a team finished once the judges' scores are
+// weighed in with the public result
+type CombinedStanding struct {
+	Rank         int
+	Team         Team
+	PublicRank   int
+	JudgeAverage float64 // Mean of every criterion score the team got, 0 if no judge scored it
+	Combined     float64 // The score the teams are ranked by, 0-100, just the public placing if no judge scored it
+}
+
+// GetCombinedStandings ranks the teams by the jam's judge weight of their
+// judge scores plus the rest of the weight on their public placing
+func (gj *Gamejam) GetCombinedStandings() []CombinedStanding {
+	return combineStandings(gj.Teams, gj.GetResults(), gj.JudgeScores, gj.JudgeCriteria, gj.JudgeWeight)
+}
+
+// combineStandings does the work for GetCombinedStandings
+// The public placing is scaled so that first is 1 and last is 0, the judge
+// average is scaled so that JudgeScoreMin is 0 and JudgeScoreMax is 1.
+// A team that no judge scored is ranked on its public placing alone, rather
+// than as if the judges had given it the lowest score.
+func combineStandings(teams []Team, public []Ranking, scores []JudgeScore, criteria []string, weight int) []CombinedStanding {
+	var ret []CombinedStanding
+	if len(teams) == 0 {
+		return ret
+	}
+	publicRank := make(map[string]int)
+	for _, r := range public {
+		for _, tm := range r.Teams {
+			publicRank[tm.UUID] = r.Rank
+		}
+	}
+	w := float64(weight) / 100
+	byTeam := make(map[string]CombinedStanding)
+	combined := make([]float64, len(teams))
+	for i, tm := range teams {
+		cs := CombinedStanding{Team: tm, PublicRank: publicRank[tm.UUID]}
+		if cs.PublicRank == 0 {
+			cs.PublicRank = len(teams)
+		}
+		publicScore := 1.0
+		if len(teams) > 1 {
+			publicScore = float64(len(teams)-cs.PublicRank) / float64(len(teams)-1)
+		}
+		if avg, cnt := judgeAverage(tm.UUID, scores, criteria); cnt > 0 {
+			cs.JudgeAverage = avg
+			judgeScore := (avg - JudgeScoreMin) / (JudgeScoreMax - JudgeScoreMin)
+			cs.Combined = 100 * (w*judgeScore + (1-w)*publicScore)
+		} else {
+			cs.Combined = 100 * publicScore
+		}
+		combined[i] = cs.Combined
+		byTeam[tm.UUID] = cs
+	}
+	for _, r := range rankByScore(teams, combined) {
+		for _, tm := range r.Teams {
+			cs := byTeam[tm.UUID]
+			cs.Rank = r.Rank
+			ret = append(ret, cs)
+		}
+	}
+	return ret
+}
+
+// judgeAverage returns the mean of the criteria scores that judges gave the
+// team along with how many scores that was
+func judgeAverage(tmId string, scores []JudgeScore, criteria []string) (float64, int) {
+	var total, cnt int
+	for _, js := range scores {
+		if js.Team != tmId {
+			continue
+		}
+		for _, c := range criteria {
+			if sc, ok := js.Scores[c]; ok {
+				total += sc
+				cnt++
+			}
+		}
+	}
+	if cnt == 0 {
+		return 0, 0
+	}
+	return float64(total) / float64(cnt), cnt
+}
+
+/**
+ * DB Functions
+ * Scores are loaded when the app starts up, and saved as soon as a judge enters them
+ */
+
+// LoadAllJudgeScores loads all of the judges' scores for the jam out of the database
+func (gj *Gamejam) LoadAllJudgeScores() []JudgeScore {
+	var err error
+	var ret []JudgeScore
+	if err = gj.m.openDB(); err != nil {
+		return ret
+	}
+	defer gj.m.closeDB()
+
+	scoresPath := append(gj.mPath, "judgescores")
+	var judges []string
+	if judges, err = gj.m.db.GetBucketList(scoresPath); err != nil {
+		return ret
+	}
+	for _, jdg := range judges {
+		var tmIds []string
+		if tmIds, err = gj.m.db.GetBucketList(append(scoresPath, jdg)); err != nil {
+			continue
+		}
+		for _, tmId := range tmIds {
+			if js, err := NewJudgeScore(jdg, tmId); err == nil {
+				js.Scores = loadScoreValues(gj.m.db, js.mPath)
+				ret = append(ret, *js)
+			}
+		}
+	}
+	return ret
+}
+
+// loadScoreValues reads the criterion scores stored in the given bucket
+func loadScoreValues(bolt Store, path []string) map[string]int {
+	ret := make(map[string]int)
+	keys, err := bolt.GetKeyList(path)
+	if err != nil {
+		return ret
+	}
+	for _, k := range keys {
+		if sc, err := bolt.GetInt(path, k); err == nil {
+			ret[k] = sc
+		}
+	}
+	return ret
+}
+
+// SaveJudgeScores saves all of the judges' scores for the jam to the database
+func (gj *Gamejam) SaveJudgeScores() error {
+	var err error
+	if err = gj.m.openDB(); err != nil {
+		return err
+	}
+	defer gj.m.closeDB()
+
+	for i := range gj.JudgeScores {
+		if err = gj.SaveJudgeScore(&gj.JudgeScores[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SaveJudgeScore saves one judge's scores for one game to the database
+func (gj *Gamejam) SaveJudgeScore(js *JudgeScore) error {
+	var err error
+	if err = gj.m.openDB(); err != nil {
+		return err
+	}
+	defer gj.m.closeDB()
+
+	for c, sc := range js.Scores {
+		if err = gj.m.db.SetInt(js.mPath, c, sc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
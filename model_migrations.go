@@ -0,0 +1,169 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+/**
+ * Schema Migrations
+ * Every DB, the main one and each archived jam's, records the version of the
+ * layout it was written with in site/schema-version. When a DB is opened,
+ * the migrations newer than its version are run on it in order, and the
+ * version is bumped after each one, so a migration that fails is picked up
+ * again the next time. Migrations have to be safe to run more than once.
+ * DBs from before there was a version are version 0.
+ *
+ * To change the layout, add a migration to the end of the list, it becomes
+ * the new schema version.
+ */
+
+// Where the schema version is kept, in every DB
+var schemaVersionPath = []string{"site"}
+
+const schemaVersionKey = "schema-version"
+
+// A migration upgrades a DB from the version before it
+// Main runs on the main DB, Archive on archived jam DBs, either can be nil
+type migration struct {
+	Description string
+	Main        func(Store) error
+	Archive     func(Store) error
+}
+
+// The migrations, in order, the first is version 1
+var migrations = []migration{
+	{
+		Description: "Move screenshots out of the DB",
+		Main:        migrateScreenshotsStep,
+		Archive:     migrateScreenshotsStep,
+	},
+	{
+		Description: "Record the tally method of jams from before it could be chosen",
+		Main:        migrateTallyMethod,
+		Archive:     migrateTallyMethod,
+	},
+	{
+		Description: "Record the places of rankings from before ties were recorded",
+		Archive:     migrateRankPlaces,
+	},
+}
+
+// schemaVersion is the version of the layout this build writes
+func schemaVersion() int {
+	return len(migrations)
+}
+
+// getSchemaVersion returns the schema version of an open DB
+func getSchemaVersion(bolt Store) int {
+	ver, err := bolt.GetInt(schemaVersionPath, schemaVersionKey)
+	if err != nil {
+		return 0
+	}
+	return ver
+}
+
+// setSchemaVersion records the schema version of an open DB
+func setSchemaVersion(bolt Store, ver int) error {
+	return bolt.SetInt(schemaVersionPath, schemaVersionKey, ver)
+}
+
+// runMigrations brings an open DB up to the current schema version
+// name is only used to say which DB is being migrated
+func runMigrations(bolt Store, name string, archive bool) error {
+	ver := getSchemaVersion(bolt)
+	if ver > schemaVersion() {
+		return fmt.Errorf("%s was written by a newer version of %s (schema %d, this version knows up to %d)", name, AppName, ver, schemaVersion())
+	}
+	for i := ver; i < len(migrations); i++ {
+		mig := migrations[i]
+		run := mig.Main
+		if archive {
+			run = mig.Archive
+		}
+		if run != nil {
+			fmt.Printf("Migrating %s to schema %d: %s\n", name, i+1, mig.Description)
+			if err := run(bolt); err != nil {
+				return errors.New("Error migrating " + name + " to schema " + strconv.Itoa(i+1) + ": " + err.Error())
+			}
+		}
+		if err := setSchemaVersion(bolt, i+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// migrateDB brings the main DB up to the current schema version
+// A new DB starts out at it, an existing one is backed up before it's migrated
+func (m *model) migrateDB(existed bool) error {
+	if err := m.openDB(); err != nil {
+		return err
+	}
+	if !existed {
+		defer m.closeDB()
+		return setSchemaVersion(m.db, schemaVersion())
+	}
+	ver := getSchemaVersion(m.db)
+	m.closeDB()
+	if ver == schemaVersion() {
+		return nil
+	}
+	if ver < schemaVersion() {
+		if _, err := m.snapshot("migrate"); err != nil {
+			return errors.New("Unable to back up before migrating: " + err.Error())
+		}
+	}
+	if err := m.openDB(); err != nil {
+		return err
+	}
+	defer m.closeDB()
+	return runMigrations(m.db, DbName, false)
+}
+
+/**
+ * The Migrations
+ */
+
+// 1: Screenshots used to be kept in the DB as base64
+func migrateScreenshotsStep(bolt Store) error {
+	moved, err := migrateScreenshots(bolt)
+	if moved > 0 {
+		fmt.Printf("Moved %d screenshots out of the DB\n", moved)
+	}
+	return err
+}
+
+// 2: Jams from before the tally method could be chosen used Condorcet
+func migrateTallyMethod(bolt Store) error {
+	if tally, _ := bolt.GetValue([]string{"jam"}, "tallymethod"); tally != "" {
+		return nil
+	}
+	return bolt.SetValue([]string{"jam"}, "tallymethod", TallyCondorcet)
+}
+
+// 3: Jams archived before ties were recorded just have a place for each team
+func migrateRankPlaces(bolt Store) error {
+	paths := [][]string{{"jam"}}
+	if catIds, err := bolt.GetBucketList([]string{"jam", "categories"}); err == nil {
+		for _, id := range catIds {
+			paths = append(paths, []string{"jam", "categories", id})
+		}
+	}
+	for _, pth := range paths {
+		if _, err := bolt.GetKeyList(append(append([]string{}, pth...), "rankplaces")); err == nil {
+			// Already has them
+			continue
+		}
+		uuids, places, tiebreaks, err := loadRankings(bolt, pth)
+		if err != nil || len(uuids) == 0 {
+			// Nothing was ranked
+			continue
+		}
+		if err = saveRankings(bolt, pth, uuids, places, tiebreaks); err != nil {
+			return err
+		}
+	}
+	return nil
+}
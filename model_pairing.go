@@ -0,0 +1,149 @@
+package main
+
+import (
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"strings"
+	"time"
+)
+
+/**
+ * Client Pairing
+ * A voting client that isn't authenticated shows a short pairing code, and
+ * an admin enters it on the Clients page (or scans the QR code for it) to
+ * authenticate the client. That way admin credentials never have to be
+ * typed on a public machine.
+ * Codes are only kept in memory, a client gets a new one once its code
+ * expires, or if the app is restarted.
+ */
+
+// How long a pairing code can be used for
+const PairingCodeTTL = 10 * time.Minute
+
+// Pairing codes are made from these, there's no 0/O or 1/I to mix up
+const pairingCodeChars = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+
+const pairingCodeLen = 6
+
+type PairingCode struct {
+	Code     string
+	ClientId string
+	IP       string
+	Expires  time.Time
+}
+
+// Display returns the code split in half, so it's easier to read out
+func (pc *PairingCode) Display() string {
+	return pc.Code[:pairingCodeLen/2] + "-" + pc.Code[pairingCodeLen/2:]
+}
+
+func newPairingCode() (string, error) {
+	ret := make([]byte, pairingCodeLen)
+	max := big.NewInt(int64(len(pairingCodeChars)))
+	for i := range ret {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		ret[i] = pairingCodeChars[n.Int64()]
+	}
+	return string(ret), nil
+}
+
+// cleanPairingCode returns code the way it's stored, however it was typed
+func cleanPairingCode(code string) string {
+	code = strings.ToUpper(code)
+	return strings.Map(func(r rune) rune {
+		if strings.ContainsRune(pairingCodeChars, r) {
+			return r
+		}
+		return -1
+	}, code)
+}
+
+// prunePairingCodes drops the codes that have expired
+func (m *model) prunePairingCodes(now time.Time) {
+	var keep []PairingCode
+	for _, pc := range m.pairings {
+		if now.Before(pc.Expires) {
+			keep = append(keep, pc)
+		}
+	}
+	m.pairings = keep
+}
+
+// PairingCodeFor returns the pairing code for a client, making one if it
+// doesn't have one
+func (m *model) PairingCodeFor(clientId, ip string) (*PairingCode, error) {
+	if clientId == "" {
+		return nil, errors.New("Client ID is required")
+	}
+	m.prunePairingCodes(time.Now())
+	for i := range m.pairings {
+		if m.pairings[i].ClientId == clientId {
+			m.pairings[i].IP = ip
+			return &m.pairings[i], nil
+		}
+	}
+	var code string
+	var err error
+	for code == "" {
+		if code, err = newPairingCode(); err != nil {
+			return nil, errors.New("Error making pairing code: " + err.Error())
+		}
+		if _, err = m.GetPairingCode(code); err == nil {
+			// Already in use
+			code = ""
+		}
+	}
+	m.pairings = append(m.pairings, PairingCode{
+		Code:     code,
+		ClientId: clientId,
+		IP:       ip,
+		Expires:  time.Now().Add(PairingCodeTTL),
+	})
+	return &m.pairings[len(m.pairings)-1], nil
+}
+
+// GetPairingCode returns the pairing code that matches code, if it hasn't
+// expired
+func (m *model) GetPairingCode(code string) (*PairingCode, error) {
+	code = cleanPairingCode(code)
+	now := time.Now()
+	for i := range m.pairings {
+		if m.pairings[i].Code == code && now.Before(m.pairings[i].Expires) {
+			return &m.pairings[i], nil
+		}
+	}
+	return nil, errors.New("Invalid or expired pairing code")
+}
+
+// PairClient authenticates the client that's showing code, and gives it
+// name if one is given
+func (m *model) PairClient(code, name string) (*Client, error) {
+	pc, err := m.GetPairingCode(code)
+	if err != nil {
+		return nil, err
+	}
+	client, err := m.GetClient(pc.ClientId)
+	if err != nil {
+		client = NewClient(pc.ClientId)
+	}
+	client.Auth = true
+	client.IP = pc.IP
+	if name != "" {
+		client.Name = name
+	}
+	if err = m.UpdateClient(client); err != nil {
+		return nil, err
+	}
+	// A code can only be used once
+	for i := range m.pairings {
+		if m.pairings[i].Code == pc.Code {
+			m.pairings = append(m.pairings[:i], m.pairings[i+1:]...)
+			break
+		}
+	}
+	return client, nil
+}
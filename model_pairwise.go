@@ -0,0 +1,93 @@
+package main
+
+// PairwiseMatrix holds the head-to-head preferences between every pair of teams
+// It is built in one pass over the votes, so tallies that need many
+// comparisons don't have to rescan the votes for each one.
+type PairwiseMatrix struct {
+	Teams []Team
+
+	prefs [][]int // prefs[i][j] is the number of votes preferring Teams[i] over Teams[j]
+}
+
+// PairwiseCell is a single head-to-head matchup, from the row team's view
+type PairwiseCell struct {
+	Opponent Team
+	For      int // Votes that preferred the row team
+	Against  int // Votes that preferred the opponent
+	Self     bool
+}
+
+// Result returns "win", "loss" or "tie" for the row team
+func (c PairwiseCell) Result() string {
+	if c.For > c.Against {
+		return "win"
+	} else if c.For < c.Against {
+		return "loss"
+	}
+	return "tie"
+}
+
+// PairwiseRow is every matchup for one team
+type PairwiseRow struct {
+	Team  Team
+	Cells []PairwiseCell
+}
+
+// NewPairwiseMatrix tallies the head-to-head preferences of votes between teams
+// Every vote is a full ballot: a ranked team is preferred over every team ranked
+// below it and every team that isn't ranked. Teams that are both unranked are
+// tied for last, so that vote doesn't count for either of them.
+func NewPairwiseMatrix(teams []Team, votes []Vote) *PairwiseMatrix {
+	pm := &PairwiseMatrix{Teams: teams}
+	pm.prefs = make([][]int, len(teams))
+	for i := range pm.prefs {
+		pm.prefs[i] = make([]int, len(teams))
+	}
+	idx := teamIndexes(teams)
+	for _, v := range votes {
+		ranked := make([]bool, len(teams))
+		for _, tmId := range ballotOrder(v) {
+			i, ok := idx[tmId]
+			if !ok {
+				// Not a team in this jam
+				continue
+			}
+			// i is preferred over everything that hasn't been ranked yet
+			for j := range teams {
+				if j != i && !ranked[j] {
+					pm.prefs[i][j]++
+				}
+			}
+			ranked[i] = true
+		}
+	}
+	return pm
+}
+
+// Prefers returns the number of votes that prefer Teams[i] over Teams[j]
+func (pm *PairwiseMatrix) Prefers(i, j int) int {
+	return pm.prefs[i][j]
+}
+
+// Beats returns whether more votes prefer Teams[i] over Teams[j] than the reverse
+func (pm *PairwiseMatrix) Beats(i, j int) bool {
+	return pm.prefs[i][j] > pm.prefs[j][i]
+}
+
+// Rows returns the matrix as a team-by-team grid for display
+func (pm *PairwiseMatrix) Rows() []PairwiseRow {
+	var ret []PairwiseRow
+	for i := range pm.Teams {
+		row := PairwiseRow{Team: pm.Teams[i]}
+		for j := range pm.Teams {
+			row.Cells = append(row.Cells, PairwiseCell{
+				Opponent: pm.Teams[j],
+				For:      pm.prefs[i][j],
+				Against:  pm.prefs[j][i],
+				Self:     i == j,
+			})
+		}
+		ret = append(ret, row)
+	}
+	return ret
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+/**
+ * Voting Window
+ * A jam can be given a time that voting opens and a time that it closes
+ * The scheduler switches the public mode when the window moves from one
+ * phase to the next, so an admin can still switch modes by hand in between.
+ */
+
+// How often the scheduler checks the voting window
+const scheduleInterval = 10 * time.Second
+
+// The format used for voting window times in forms (datetime-local inputs)
+const votingWindowInputFormat = "2006-01-02T15:04"
+
+// SetVotingWindow sets when voting opens and closes
+// Either can be the zero time to leave that end of the window unscheduled
+func (gj *Gamejam) SetVotingWindow(opens, closes time.Time) error {
+	if !opens.IsZero() && !closes.IsZero() && !closes.After(opens) {
+		return errors.New("Voting has to close after it opens")
+	}
+	gj.VotingOpens = opens
+	gj.VotingCloses = closes
+	gj.IsChanged = true
+	if gj.m != nil && gj.m.site != nil {
+		// Let the scheduler apply the new window right away
+		gj.m.site.scheduledMode = -1
+		gj.m.site.changed = true
+	}
+	return nil
+}
+
+// HasVotingWindow returns whether either end of the voting window is scheduled
+func (gj *Gamejam) HasVotingWindow() bool {
+	return !gj.VotingOpens.IsZero() || !gj.VotingCloses.IsZero()
+}
+
+// VotingWindowMode returns the public mode that the voting window calls for
+// at the given time, or -1 if the window doesn't call for one
+func (gj *Gamejam) VotingWindowMode(now time.Time) int {
+	if !gj.VotingCloses.IsZero() && !now.Before(gj.VotingCloses) {
+		return SiteModeResults
+	}
+	if !gj.VotingOpens.IsZero() {
+		if now.Before(gj.VotingOpens) {
+			return SiteModeWaiting
+		}
+		return SiteModeVoting
+	}
+	return -1
+}
+
+// VotingIsClosed returns whether the voting window closed before the given time
+func (gj *Gamejam) VotingIsClosed(now time.Time) bool {
+	return !gj.VotingCloses.IsZero() && !now.Before(gj.VotingCloses)
+}
+
+// formatWindowTime formats a voting window time for the DB, zero is stored as ""
+func formatWindowTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+// checkVotingWindow switches the public mode if the voting window has moved
+// into a different phase since the last check
+func (m *model) checkVotingWindow(now time.Time) {
+	mode := m.jam.VotingWindowMode(now)
+	if mode == -1 || mode == m.site.scheduledMode {
+		return
+	}
+	m.site.scheduledMode = mode
+	m.site.changed = true
+	if mode != m.site.GetPublicMode() {
+		if err := m.site.SetPublicMode(mode); err != nil {
+			fmt.Println("Error switching public mode: " + err.Error())
+			return
+		}
+		fmt.Println("Voting window: switched public mode to " + siteModeName(mode))
+	}
+	if err := m.site.SaveToDB(); err != nil {
+		fmt.Println("Error saving public mode: " + err.Error())
+	}
+}
+
+// runScheduler checks the voting window until the app exits
+func (m *model) runScheduler() {
+	for {
+		m.mu.Lock()
+		m.checkVotingWindow(time.Now())
+		m.mu.Unlock()
+		time.Sleep(scheduleInterval)
+	}
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"errors"
+	"net"
 	"strconv"
 	"strings"
 )
@@ -22,6 +23,21 @@ type siteData struct {
 	DevMode bool
 	Mode    int
 
+	// Screenshot uploads
+	ThumbnailSizes []int // Widths of the thumbnails made for each screenshot
+	MaxUploadSize  int   // The biggest file accepted, in bytes
+	MaxImageSize   int   // The most pixels wide or tall a screenshot can be
+
+	// Backups
+	BackupInterval int // Minutes between scheduled backups, 0 turns them off
+	BackupKeep     int // How many backups are kept
+
+	// Proxies that are trusted to say who the client is in X-Forwarded-For
+	TrustedProxies []*net.IPNet
+
+	// The public mode the voting window last called for, -1 if it hasn't yet
+	scheduledMode int
+
 	m       *model
 	mPath   []string // The path in the db to this site data
 	changed bool
@@ -37,6 +53,12 @@ func NewSiteData(m *model) *siteData {
 	ret.Port = 8080
 	ret.SessionName = "ict-gamejam"
 	ret.ServerDir = "./"
+	ret.ThumbnailSizes = DefaultThumbnailSizes
+	ret.MaxUploadSize = DefaultMaxUploadSize
+	ret.MaxImageSize = DefaultMaxImageSize
+	ret.BackupInterval = DefaultBackupInterval
+	ret.BackupKeep = DefaultBackupKeep
+	ret.scheduledMode = -1
 	ret.mPath = []string{"site"}
 	ret.m = m
 	return ret
@@ -53,9 +75,34 @@ const (
 const (
 	SiteModeWaiting = iota
 	SiteModeVoting
+	SiteModeResults // Voting is closed, show the final standings
 	SiteModeError
 )
 
+// siteModeName returns a display name for a public mode
+func siteModeName(mode int) string {
+	switch mode {
+	case SiteModeWaiting:
+		return "Waiting"
+	case SiteModeVoting:
+		return "Voting"
+	case SiteModeResults:
+		return "Results"
+	}
+	return "Unknown"
+}
+
+// authModeName returns a display name for an authentication mode
+func authModeName(mode int) string {
+	switch mode {
+	case AuthModeAuthentication:
+		return "Only Authenticated Clients"
+	case AuthModeAll:
+		return "Any Client"
+	}
+	return "Unknown"
+}
+
 // load the site data out of the database
 // If fields don't exist in the DB, don't clobber what is already in s
 func (s *siteData) LoadFromDB() error {
@@ -64,23 +111,61 @@ func (s *siteData) LoadFromDB() error {
 	}
 	defer s.m.closeDB()
 
-	if title, _ := s.m.bolt.GetValue(s.mPath, "title"); strings.TrimSpace(title) != "" {
+	if title, _ := s.m.db.GetValue(s.mPath, "title"); strings.TrimSpace(title) != "" {
 		s.Title = title
 	}
-	if ip, err := s.m.bolt.GetValue(s.mPath, "ip"); err == nil {
+	if ip, err := s.m.db.GetValue(s.mPath, "ip"); err == nil {
 		s.Ip = ip
 	}
-	if port, err := s.m.bolt.GetInt(s.mPath, "port"); err == nil {
+	if port, err := s.m.db.GetInt(s.mPath, "port"); err == nil {
 		s.Port = port
 	}
-	if sessionName, _ := s.m.bolt.GetValue(s.mPath, "session-name"); strings.TrimSpace(sessionName) != "" {
+	if sessionName, _ := s.m.db.GetValue(s.mPath, "session-name"); strings.TrimSpace(sessionName) != "" {
 		s.SessionName = sessionName
 	}
-	if serverDir, _ := s.m.bolt.GetValue(s.mPath, "server-dir"); strings.TrimSpace(serverDir) != "" {
+	if serverDir, _ := s.m.db.GetValue(s.mPath, "server-dir"); strings.TrimSpace(serverDir) != "" {
 		s.ServerDir = serverDir
 	}
+	if authMode, err := s.m.db.GetInt(s.mPath, "auth-mode"); err == nil {
+		if authMode >= AuthModeAuthentication && authMode < AuthModeError {
+			s.authMode = authMode
+		}
+	}
+	if publicMode, err := s.m.db.GetInt(s.mPath, "public-mode"); err == nil {
+		if publicMode >= SiteModeWaiting && publicMode < SiteModeError {
+			s.publicMode = publicMode
+		}
+	}
+	if mode, err := s.m.db.GetInt(s.mPath, "mode"); err == nil {
+		s.Mode = mode
+	}
+	if scheduledMode, err := s.m.db.GetInt(s.mPath, "scheduled-mode"); err == nil {
+		s.scheduledMode = scheduledMode
+	}
+	if thmSizes, _ := s.m.db.GetValue(s.mPath, "thumbnail-sizes"); thmSizes != "" {
+		if sizes, err := parseThumbnailSizes(thmSizes); err == nil {
+			s.ThumbnailSizes = sizes
+		}
+	}
+	if maxUpload, err := s.m.db.GetInt(s.mPath, "max-upload-size"); err == nil && maxUpload > 0 {
+		s.MaxUploadSize = maxUpload
+	}
+	if maxImage, err := s.m.db.GetInt(s.mPath, "max-image-size"); err == nil && maxImage > 0 {
+		s.MaxImageSize = maxImage
+	}
+	if interval, err := s.m.db.GetInt(s.mPath, "backup-interval"); err == nil && interval >= 0 {
+		s.BackupInterval = interval
+	}
+	if keep, err := s.m.db.GetInt(s.mPath, "backup-keep"); err == nil && keep > 0 {
+		s.BackupKeep = keep
+	}
+	if proxies, _ := s.m.db.GetValue(s.mPath, "trusted-proxies"); proxies != "" {
+		if nets, err := parseTrustedProxies(proxies); err == nil {
+			s.TrustedProxies = nets
+		}
+	}
 	s.changed = false
-	if secret, _ := s.m.bolt.GetValue(s.mPath, "session-secret"); strings.TrimSpace(secret) != "" {
+	if secret, _ := s.m.db.GetValue(s.mPath, "session-secret"); strings.TrimSpace(secret) != "" {
 		s.sessionSecret = secret
 	}
 	return nil
@@ -99,23 +184,57 @@ func (s *siteData) SaveToDB() error {
 	}
 	defer s.m.closeDB()
 
-	if err = s.m.bolt.SetValue(s.mPath, "title", s.Title); err != nil {
+	if err = s.m.db.SetValue(s.mPath, "title", s.Title); err != nil {
+		return err
+	}
+	if err = s.m.db.SetValue(s.mPath, "ip", s.Ip); err != nil {
+		return err
+	}
+	if err = s.m.db.SetInt(s.mPath, "port", s.Port); err != nil {
+		return err
+	}
+	if err = s.m.db.SetValue(s.mPath, "session-name", s.SessionName); err != nil {
 		return err
 	}
-	if err = s.m.bolt.SetValue(s.mPath, "ip", s.Ip); err != nil {
+	if err = s.m.db.SetValue(s.mPath, "server-dir", s.ServerDir); err != nil {
 		return err
 	}
-	if err = s.m.bolt.SetInt(s.mPath, "port", s.Port); err != nil {
+	if err = s.m.db.SetInt(s.mPath, "auth-mode", s.authMode); err != nil {
 		return err
 	}
-	if err = s.m.bolt.SetValue(s.mPath, "session-name", s.SessionName); err != nil {
+	if err = s.m.db.SetInt(s.mPath, "public-mode", s.publicMode); err != nil {
 		return err
 	}
-	if err = s.m.bolt.SetValue(s.mPath, "server-dir", s.ServerDir); err != nil {
+	if err = s.m.db.SetInt(s.mPath, "mode", s.Mode); err != nil {
+		return err
+	}
+	if err = s.m.db.SetInt(s.mPath, "scheduled-mode", s.scheduledMode); err != nil {
+		return err
+	}
+	var thmSizes []string
+	for _, sz := range s.ThumbnailSizes {
+		thmSizes = append(thmSizes, strconv.Itoa(sz))
+	}
+	if err = s.m.db.SetValue(s.mPath, "thumbnail-sizes", strings.Join(thmSizes, ",")); err != nil {
+		return err
+	}
+	if err = s.m.db.SetInt(s.mPath, "max-upload-size", s.MaxUploadSize); err != nil {
+		return err
+	}
+	if err = s.m.db.SetInt(s.mPath, "max-image-size", s.MaxImageSize); err != nil {
+		return err
+	}
+	if err = s.m.db.SetInt(s.mPath, "backup-interval", s.BackupInterval); err != nil {
+		return err
+	}
+	if err = s.m.db.SetInt(s.mPath, "backup-keep", s.BackupKeep); err != nil {
+		return err
+	}
+	if err = s.m.db.SetValue(s.mPath, "trusted-proxies", trustedProxiesString(s.TrustedProxies)); err != nil {
 		return err
 	}
 	s.changed = false
-	if err = s.m.bolt.SetValue(s.mPath, "session-secret", s.sessionSecret); err != nil {
+	if err = s.m.db.SetValue(s.mPath, "session-secret", s.sessionSecret); err != nil {
 		return err
 	}
 	return nil
@@ -151,6 +270,60 @@ func (s *siteData) SetPublicMode(mode int) error {
 	if mode != s.publicMode {
 		s.publicMode = mode
 		s.changed = true
+		s.m.publishEvent(EventMode)
 	}
 	return nil
 }
+
+// parseTrustedProxies parses a comma separated list of IP addresses and
+// CIDR ranges
+func parseTrustedProxies(val string) ([]*net.IPNet, error) {
+	var ret []*net.IPNet
+	for _, v := range strings.Split(val, ",") {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			continue
+		}
+		if !strings.Contains(v, "/") {
+			// Just the one address
+			ip := net.ParseIP(v)
+			if ip == nil {
+				return nil, errors.New("Invalid proxy address: " + v)
+			}
+			bits := 8 * net.IPv4len
+			if ip.To4() == nil {
+				bits = 8 * net.IPv6len
+			}
+			v += "/" + strconv.Itoa(bits)
+		}
+		_, n, err := net.ParseCIDR(v)
+		if err != nil {
+			return nil, errors.New("Invalid proxy address: " + v)
+		}
+		ret = append(ret, n)
+	}
+	return ret, nil
+}
+
+// trustedProxiesString returns the proxies the way parseTrustedProxies reads them
+func trustedProxiesString(nets []*net.IPNet) string {
+	var ret []string
+	for _, n := range nets {
+		ret = append(ret, n.String())
+	}
+	return strings.Join(ret, ",")
+}
+
+// IsTrustedProxy returns whether ip is one of the trusted proxies
+func (s *siteData) IsTrustedProxy(ip string) bool {
+	addr := net.ParseIP(ip)
+	if addr == nil {
+		return false
+	}
+	for _, n := range s.TrustedProxies {
+		if n.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
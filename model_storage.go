@@ -0,0 +1,707 @@
+package main
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"github.com/br0xen/boltease"
+)
+
+/**
+ * Storage
+ * The model keeps everything in nested buckets of key/value pairs, the main
+ * DB plus one DB for each archived jam. A Storage is where those DBs live,
+ * and a Store is one of them. BoltStorage keeps them as bolt files in a
+ * directory, MemStorage keeps them in memory (for tests, or a site that
+ * doesn't need to keep anything).
+ */
+
+// Store is one DB, the methods are the ones that boltease.DB has
+// Getting from a bucket that doesn't exist is an error, a key that doesn't
+// exist is "". Setting a value makes any buckets on its path. Lists are
+// sorted by key.
+type Store interface {
+	MkBucketPath(path []string) error
+	GetValue(path []string, key string) (string, error)
+	SetValue(path []string, key, val string) error
+	GetInt(path []string, key string) (int, error)
+	SetInt(path []string, key string, val int) error
+	GetBool(path []string, key string) (bool, error)
+	SetBool(path []string, key string, val bool) error
+	GetBucketList(path []string) ([]string, error)
+	GetKeyList(path []string) ([]string, error)
+	GetValueList(path []string) ([]string, error)
+	DeletePair(path []string, key string) error
+	DeleteBucket(path []string, key string) error
+	CloseDB() error
+	// Update runs fn in a transaction, either all of the writes that fn
+	// makes to tx are saved or, if it returns an error, none of them are
+	// tx can only be used until fn returns, and fn shouldn't use the Store
+	// itself
+	Update(fn func(tx Store) error) error
+}
+
+// Storage holds the DBs, by name
+type Storage interface {
+	// Open opens the named DB, making it if it doesn't exist
+	Open(name string) (Store, error)
+	Exists(name string) bool
+	Remove(name string) error
+	// Rename moves the DB from to to, replacing any DB that's already there
+	// Neither should be open
+	Rename(from, to string) error
+	// List returns the names of all of the DBs
+	List() ([]string, error)
+	// ModTime returns when the named DB was last written to
+	ModTime(name string) (time.Time, error)
+	// Snapshot writes a copy of the named DB to the bolt file dst, and
+	// Restore replaces the named DB with the bolt file src
+	// The DB shouldn't be open for either
+	Snapshot(name, dst string) error
+	Restore(name, src string) error
+}
+
+// archiveDBName is the name of an archived jam's DB
+func archiveDBName(uuid string) string {
+	return "gamejam_" + uuid + ".db"
+}
+
+/**
+ * Bolt Storage
+ */
+
+// BoltStorage keeps each DB in a bolt file in a directory
+type BoltStorage struct {
+	dir string
+}
+
+func NewBoltStorage(dir string) *BoltStorage {
+	return &BoltStorage{dir: dir}
+}
+
+func (s *BoltStorage) path(name string) string {
+	return filepath.Join(s.dir, name)
+}
+
+func (s *BoltStorage) Open(name string) (Store, error) {
+	db, err := boltease.Create(s.path(name), 0600, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &boltStore{DB: db, fn: s.path(name)}, nil
+}
+
+func (s *BoltStorage) Exists(name string) bool {
+	_, err := os.Stat(s.path(name))
+	return err == nil
+}
+
+func (s *BoltStorage) Remove(name string) error {
+	if err := os.Remove(s.path(name)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func (s *BoltStorage) Rename(from, to string) error {
+	return os.Rename(s.path(from), s.path(to))
+}
+
+func (s *BoltStorage) List() ([]string, error) {
+	fns, err := filepath.Glob(s.path("*.db"))
+	if err != nil {
+		return nil, err
+	}
+	var ret []string
+	for _, fn := range fns {
+		ret = append(ret, filepath.Base(fn))
+	}
+	sort.Strings(ret)
+	return ret, nil
+}
+
+func (s *BoltStorage) ModTime(name string) (time.Time, error) {
+	fi, err := os.Stat(s.path(name))
+	if err != nil {
+		return time.Time{}, err
+	}
+	return fi.ModTime(), nil
+}
+
+func (s *BoltStorage) Snapshot(name, dst string) error {
+	return snapshotDB(s.path(name), dst)
+}
+
+func (s *BoltStorage) Restore(name, src string) error {
+	return copyFile(src, s.path(name))
+}
+
+// boltStore is a DB in a BoltStorage, boltease does everything but
+// transactions and deleting pairs
+type boltStore struct {
+	*boltease.DB
+	fn string
+}
+
+func (db *boltStore) Update(fn func(tx Store) error) error {
+	bdb, err := bolt.Open(db.fn, 0600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return err
+	}
+	err = bdb.Update(func(tx *bolt.Tx) error {
+		return fn(&boltTx{tx: tx})
+	})
+	if cerr := bdb.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+// DeletePair replaces boltease's, which can't find buckets more than two
+// deep
+func (db *boltStore) DeletePair(path []string, key string) error {
+	return db.Update(func(tx Store) error {
+		return tx.DeletePair(path, key)
+	})
+}
+
+// boltTx is the Store that a boltStore's Update passes to its function
+type boltTx struct {
+	tx *bolt.Tx
+}
+
+// find returns the bucket at path, making it if mk is set
+func (t *boltTx) find(path []string, mk bool) (*bolt.Bucket, error) {
+	if len(path) == 0 {
+		return nil, errors.New("No bucket path given")
+	}
+	var bkt *bolt.Bucket
+	for idx := range path {
+		var nxt *bolt.Bucket
+		if bkt == nil {
+			nxt = t.tx.Bucket([]byte(path[idx]))
+		} else {
+			nxt = bkt.Bucket([]byte(path[idx]))
+		}
+		if nxt == nil {
+			if !mk {
+				return nil, errors.New("Couldn't find bucket " + strings.Join(path[:idx+1], "/"))
+			}
+			var err error
+			if bkt == nil {
+				nxt, err = t.tx.CreateBucket([]byte(path[idx]))
+			} else {
+				nxt, err = bkt.CreateBucket([]byte(path[idx]))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+		bkt = nxt
+	}
+	return bkt, nil
+}
+
+func (t *boltTx) MkBucketPath(path []string) error {
+	_, err := t.find(path, true)
+	return err
+}
+
+func (t *boltTx) GetValue(path []string, key string) (string, error) {
+	bkt, err := t.find(path, false)
+	if err != nil {
+		return "", err
+	}
+	return string(bkt.Get([]byte(key))), nil
+}
+
+func (t *boltTx) SetValue(path []string, key, val string) error {
+	bkt, err := t.find(path, true)
+	if err != nil {
+		return err
+	}
+	return bkt.Put([]byte(key), []byte(val))
+}
+
+func (t *boltTx) GetInt(path []string, key string) (int, error) {
+	r, err := t.GetValue(path, key)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(r)
+}
+
+func (t *boltTx) SetInt(path []string, key string, val int) error {
+	return t.SetValue(path, key, strconv.Itoa(val))
+}
+
+func (t *boltTx) GetBool(path []string, key string) (bool, error) {
+	r, err := t.GetValue(path, key)
+	if err != nil {
+		return false, err
+	}
+	return parseStoreBool(r)
+}
+
+func (t *boltTx) SetBool(path []string, key string, val bool) error {
+	return t.SetValue(path, key, strconv.FormatBool(val))
+}
+
+// list returns the keys in the bucket at path, either the ones that are
+// buckets or the ones that are values
+func (t *boltTx) list(path []string, buckets bool) ([]string, []string, error) {
+	bkt, err := t.find(path, false)
+	if err != nil {
+		return nil, nil, err
+	}
+	var keys, vals []string
+	err = bkt.ForEach(func(k, v []byte) error {
+		if (v == nil) == buckets {
+			keys = append(keys, string(k))
+			vals = append(vals, string(v))
+		}
+		return nil
+	})
+	return keys, vals, err
+}
+
+func (t *boltTx) GetBucketList(path []string) ([]string, error) {
+	ret, _, err := t.list(path, true)
+	return ret, err
+}
+
+func (t *boltTx) GetKeyList(path []string) ([]string, error) {
+	ret, _, err := t.list(path, false)
+	return ret, err
+}
+
+func (t *boltTx) GetValueList(path []string) ([]string, error) {
+	_, ret, err := t.list(path, false)
+	return ret, err
+}
+
+func (t *boltTx) DeletePair(path []string, key string) error {
+	bkt, err := t.find(path, false)
+	if err != nil {
+		return err
+	}
+	if bkt.Bucket([]byte(key)) != nil {
+		return nil
+	}
+	return bkt.Delete([]byte(key))
+}
+
+func (t *boltTx) DeleteBucket(path []string, key string) error {
+	bkt, err := t.find(path, false)
+	if err != nil {
+		return err
+	}
+	if bkt.Bucket([]byte(key)) == nil {
+		return nil
+	}
+	return bkt.DeleteBucket([]byte(key))
+}
+
+// CloseDB doesn't do anything, the transaction ends when Update's function
+// returns
+func (t *boltTx) CloseDB() error {
+	return nil
+}
+
+// Update just runs fn, it's already in a transaction
+func (t *boltTx) Update(fn func(tx Store) error) error {
+	return fn(t)
+}
+
+// parseStoreBool reads a value written by SetBool, the same way boltease
+// does
+func parseStoreBool(r string) (bool, error) {
+	switch r {
+	case "true", "1":
+		return true, nil
+	case "false", "0":
+		return false, nil
+	}
+	return false, errors.New("Cannot parse as a boolean")
+}
+
+/**
+ * Memory Storage
+ */
+
+// MemStorage keeps the DBs in memory, they're gone when the app exits
+type MemStorage struct {
+	mu  sync.Mutex
+	dbs map[string]*memStore
+}
+
+func NewMemStorage() *MemStorage {
+	return &MemStorage{dbs: make(map[string]*memStore)}
+}
+
+func (s *MemStorage) Open(name string) (Store, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	db, ok := s.dbs[name]
+	if !ok {
+		db = newMemStore()
+		s.dbs[name] = db
+	}
+	return db, nil
+}
+
+func (s *MemStorage) Exists(name string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok := s.dbs[name]
+	return ok
+}
+
+func (s *MemStorage) Remove(name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.dbs, name)
+	return nil
+}
+
+func (s *MemStorage) Rename(from, to string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	db, ok := s.dbs[from]
+	if !ok {
+		return errors.New("Couldn't find DB " + from)
+	}
+	s.dbs[to] = db
+	delete(s.dbs, from)
+	return nil
+}
+
+func (s *MemStorage) List() ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var ret []string
+	for name := range s.dbs {
+		ret = append(ret, name)
+	}
+	sort.Strings(ret)
+	return ret, nil
+}
+
+func (s *MemStorage) get(name string) (*memStore, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	db, ok := s.dbs[name]
+	if !ok {
+		return nil, errors.New("Couldn't find DB " + name)
+	}
+	return db, nil
+}
+
+func (s *MemStorage) ModTime(name string) (time.Time, error) {
+	db, err := s.get(name)
+	if err != nil {
+		return time.Time{}, err
+	}
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	return db.modified, nil
+}
+
+// Snapshot writes the named DB out as a bolt file, so that it can be backed
+// up the same as one from BoltStorage
+func (s *MemStorage) Snapshot(name, dst string) error {
+	db, err := s.get(name)
+	if err != nil {
+		return err
+	}
+	out, err := bolt.Open(dst, 0600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return err
+	}
+	db.mu.Lock()
+	err = out.Update(func(tx *bolt.Tx) error {
+		for k, sub := range db.root.buckets {
+			bkt, err := tx.CreateBucket([]byte(k))
+			if err != nil {
+				return err
+			}
+			if err = sub.writeTo(bkt); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	db.mu.Unlock()
+	if cerr := out.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(dst)
+	}
+	return err
+}
+
+// Restore reads the named DB in from a bolt file
+func (s *MemStorage) Restore(name, src string) error {
+	in, err := bolt.Open(src, 0600, &bolt.Options{ReadOnly: true, Timeout: 5 * time.Second})
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	db := newMemStore()
+	err = in.View(func(tx *bolt.Tx) error {
+		return tx.ForEach(func(k []byte, bkt *bolt.Bucket) error {
+			sub := newMemBucket()
+			db.root.buckets[string(k)] = sub
+			return sub.readFrom(bkt)
+		})
+	})
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	s.dbs[name] = db
+	s.mu.Unlock()
+	return nil
+}
+
+// memStore is a DB in a MemStorage
+type memStore struct {
+	mu       sync.Mutex
+	root     *memBucket
+	modified time.Time
+}
+
+type memBucket struct {
+	buckets map[string]*memBucket
+	values  map[string]string
+}
+
+func newMemStore() *memStore {
+	return &memStore{root: newMemBucket(), modified: time.Now()}
+}
+
+func newMemBucket() *memBucket {
+	return &memBucket{buckets: make(map[string]*memBucket), values: make(map[string]string)}
+}
+
+func (b *memBucket) clone() *memBucket {
+	ret := newMemBucket()
+	for k, v := range b.values {
+		ret.values[k] = v
+	}
+	for k, sub := range b.buckets {
+		ret.buckets[k] = sub.clone()
+	}
+	return ret
+}
+
+// writeTo copies the bucket's contents into a bolt bucket
+func (b *memBucket) writeTo(bkt *bolt.Bucket) error {
+	for k, v := range b.values {
+		if err := bkt.Put([]byte(k), []byte(v)); err != nil {
+			return err
+		}
+	}
+	for k, sub := range b.buckets {
+		subBkt, err := bkt.CreateBucket([]byte(k))
+		if err != nil {
+			return err
+		}
+		if err = sub.writeTo(subBkt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readFrom copies a bolt bucket's contents into the bucket
+func (b *memBucket) readFrom(bkt *bolt.Bucket) error {
+	return bkt.ForEach(func(k, v []byte) error {
+		if v != nil {
+			b.values[string(k)] = string(v)
+			return nil
+		}
+		sub := newMemBucket()
+		b.buckets[string(k)] = sub
+		return sub.readFrom(bkt.Bucket(k))
+	})
+}
+
+// find returns the bucket at path, making it if mk is set
+func (db *memStore) find(path []string, mk bool) (*memBucket, error) {
+	if len(path) == 0 {
+		return nil, errors.New("No bucket path given")
+	}
+	bkt := db.root
+	for idx := range path {
+		nxt, ok := bkt.buckets[path[idx]]
+		if !ok {
+			if !mk {
+				return nil, errors.New("Couldn't find bucket " + strings.Join(path[:idx+1], "/"))
+			}
+			if _, ok = bkt.values[path[idx]]; ok {
+				return nil, errors.New("Incompatible value at " + strings.Join(path[:idx+1], "/"))
+			}
+			nxt = newMemBucket()
+			bkt.buckets[path[idx]] = nxt
+		}
+		bkt = nxt
+	}
+	return bkt, nil
+}
+
+func (db *memStore) MkBucketPath(path []string) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	_, err := db.find(path, true)
+	db.modified = time.Now()
+	return err
+}
+
+func (db *memStore) GetValue(path []string, key string) (string, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	bkt, err := db.find(path, false)
+	if err != nil {
+		return "", err
+	}
+	return bkt.values[key], nil
+}
+
+func (db *memStore) SetValue(path []string, key, val string) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	bkt, err := db.find(path, true)
+	if err != nil {
+		return err
+	}
+	if _, ok := bkt.buckets[key]; ok {
+		return errors.New("Incompatible value at " + strings.Join(path, "/") + "/" + key)
+	}
+	bkt.values[key] = val
+	db.modified = time.Now()
+	return nil
+}
+
+func (db *memStore) GetInt(path []string, key string) (int, error) {
+	r, err := db.GetValue(path, key)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(r)
+}
+
+func (db *memStore) SetInt(path []string, key string, val int) error {
+	return db.SetValue(path, key, strconv.Itoa(val))
+}
+
+func (db *memStore) GetBool(path []string, key string) (bool, error) {
+	r, err := db.GetValue(path, key)
+	if err != nil {
+		return false, err
+	}
+	return parseStoreBool(r)
+}
+
+func (db *memStore) SetBool(path []string, key string, val bool) error {
+	return db.SetValue(path, key, strconv.FormatBool(val))
+}
+
+func (db *memStore) GetBucketList(path []string) ([]string, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	bkt, err := db.find(path, false)
+	if err != nil {
+		return nil, err
+	}
+	var ret []string
+	for k := range bkt.buckets {
+		ret = append(ret, k)
+	}
+	sort.Strings(ret)
+	return ret, nil
+}
+
+func (db *memStore) GetKeyList(path []string) ([]string, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	bkt, err := db.find(path, false)
+	if err != nil {
+		return nil, err
+	}
+	var ret []string
+	for k := range bkt.values {
+		ret = append(ret, k)
+	}
+	sort.Strings(ret)
+	return ret, nil
+}
+
+func (db *memStore) GetValueList(path []string) ([]string, error) {
+	keys, err := db.GetKeyList(path)
+	if err != nil {
+		return nil, err
+	}
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	bkt, err := db.find(path, false)
+	if err != nil {
+		return nil, err
+	}
+	var ret []string
+	for _, k := range keys {
+		ret = append(ret, bkt.values[k])
+	}
+	return ret, nil
+}
+
+func (db *memStore) DeletePair(path []string, key string) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	bkt, err := db.find(path, false)
+	if err != nil {
+		return err
+	}
+	delete(bkt.values, key)
+	db.modified = time.Now()
+	return nil
+}
+
+func (db *memStore) DeleteBucket(path []string, key string) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	bkt, err := db.find(path, false)
+	if err != nil {
+		return err
+	}
+	delete(bkt.buckets, key)
+	db.modified = time.Now()
+	return nil
+}
+
+// CloseDB doesn't do anything, the DB stays in its MemStorage
+func (db *memStore) CloseDB() error {
+	return nil
+}
+
+// Update runs fn against a copy of the DB, and swaps the copy in if fn
+// doesn't return an error
+func (db *memStore) Update(fn func(tx Store) error) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	tx := &memStore{root: db.root.clone(), modified: db.modified}
+	if err := fn(tx); err != nil {
+		return err
+	}
+	db.root = tx.root
+	db.modified = tx.modified
+	return nil
+}
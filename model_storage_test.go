@@ -0,0 +1,265 @@
+package main
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// tempDir makes a directory for a test, and returns a func that removes it
+func tempDir(t *testing.T) (string, func()) {
+	t.Helper()
+	dir, err := ioutil.TempDir("", "gjvote-test")
+	if err != nil {
+		t.Fatalf("Error making temp dir: %v", err)
+	}
+	return dir, func() { os.RemoveAll(dir) }
+}
+
+// boltdb/bolt trips the race detector's pointer checks, so nothing that opens
+// a bolt file can run under -race
+const boltRaceSkip = "bolt fails checkptr under -race"
+
+// forEachStorage runs fn as a subtest against each Storage backend
+func forEachStorage(t *testing.T, fn func(t *testing.T, st Storage)) {
+	t.Run("bolt", func(t *testing.T) {
+		if raceEnabled {
+			t.Skip(boltRaceSkip)
+		}
+		dir, cleanup := tempDir(t)
+		defer cleanup()
+		fn(t, NewBoltStorage(dir))
+	})
+	t.Run("mem", func(t *testing.T) {
+		fn(t, NewMemStorage())
+	})
+}
+
+func openTestStore(t *testing.T, st Storage) Store {
+	t.Helper()
+	db, err := st.Open("test.db")
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	return db
+}
+
+func TestStoreNestedPaths(t *testing.T) {
+	forEachStorage(t, func(t *testing.T, st Storage) {
+		db := openTestStore(t, st)
+		deep := []string{"jam", "teams", "t1", "game", "screenshots", "s1"}
+		if err := db.SetValue(deep, "description", "A screenshot"); err != nil {
+			t.Fatalf("SetValue: %v", err)
+		}
+		if v, err := db.GetValue(deep, "description"); err != nil || v != "A screenshot" {
+			t.Errorf("GetValue = %q, %v", v, err)
+		}
+		// A key that isn't there is blank, a bucket that isn't there is an error
+		if v, err := db.GetValue(deep, "missing"); err != nil || v != "" {
+			t.Errorf("GetValue of a missing key = %q, %v", v, err)
+		}
+		if _, err := db.GetValue([]string{"jam", "teams", "t2"}, "name"); err == nil {
+			t.Error("GetValue from a missing bucket didn't fail")
+		}
+		if err := db.MkBucketPath([]string{"jam", "teams", "t2", "members"}); err != nil {
+			t.Fatalf("MkBucketPath: %v", err)
+		}
+		if err := db.SetInt(deep, "order", 3); err != nil {
+			t.Fatalf("SetInt: %v", err)
+		}
+		if v, err := db.GetInt(deep, "order"); err != nil || v != 3 {
+			t.Errorf("GetInt = %d, %v", v, err)
+		}
+		if err := db.SetBool(deep, "public", true); err != nil {
+			t.Fatalf("SetBool: %v", err)
+		}
+		if v, err := db.GetBool(deep, "public"); err != nil || !v {
+			t.Errorf("GetBool = %t, %v", v, err)
+		}
+		if _, err := db.GetBool(deep, "description"); err == nil {
+			t.Error("GetBool of a string didn't fail")
+		}
+	})
+}
+
+func TestStoreLists(t *testing.T) {
+	forEachStorage(t, func(t *testing.T, st Storage) {
+		db := openTestStore(t, st)
+		pth := []string{"jam", "teams"}
+		for _, id := range []string{"c", "a", "b"} {
+			if err := db.SetValue(append(pth, id), "name", "Team "+id); err != nil {
+				t.Fatalf("SetValue: %v", err)
+			}
+		}
+		db.SetValue(pth, "zz", "2")
+		db.SetValue(pth, "yy", "1")
+
+		bkts, err := db.GetBucketList(pth)
+		if want := []string{"a", "b", "c"}; err != nil || !reflect.DeepEqual(bkts, want) {
+			t.Errorf("GetBucketList = %v, %v, want %v", bkts, err, want)
+		}
+		keys, err := db.GetKeyList(pth)
+		if want := []string{"yy", "zz"}; err != nil || !reflect.DeepEqual(keys, want) {
+			t.Errorf("GetKeyList = %v, %v, want %v", keys, err, want)
+		}
+		vals, err := db.GetValueList(pth)
+		if want := []string{"1", "2"}; err != nil || !reflect.DeepEqual(vals, want) {
+			t.Errorf("GetValueList = %v, %v, want %v", vals, err, want)
+		}
+		if _, err = db.GetBucketList([]string{"jam", "votes"}); err == nil {
+			t.Error("GetBucketList of a missing bucket didn't fail")
+		}
+	})
+}
+
+func TestStoreDelete(t *testing.T) {
+	forEachStorage(t, func(t *testing.T, st Storage) {
+		db := openTestStore(t, st)
+		deep := []string{"jam", "teams", "t1", "game", "screenshots", "s1"}
+		db.SetValue(deep, "image", "base64")
+		db.SetValue(deep, "imagehash", "hash")
+		db.SetValue(append(deep, "thumbnails"), "200", "thumb")
+
+		if err := db.DeletePair(deep, "image"); err != nil {
+			t.Fatalf("DeletePair: %v", err)
+		}
+		keys, _ := db.GetKeyList(deep)
+		if want := []string{"imagehash"}; !reflect.DeepEqual(keys, want) {
+			t.Errorf("keys after DeletePair = %v, want %v", keys, want)
+		}
+		// DeletePair leaves buckets alone
+		if err := db.DeletePair(deep, "thumbnails"); err != nil {
+			t.Fatalf("DeletePair of a bucket: %v", err)
+		}
+		if bkts, _ := db.GetBucketList(deep); len(bkts) != 1 {
+			t.Errorf("DeletePair removed a bucket: %v", bkts)
+		}
+
+		ssPath := deep[:len(deep)-1]
+		if err := db.DeleteBucket(ssPath, "s1"); err != nil {
+			t.Fatalf("DeleteBucket: %v", err)
+		}
+		if bkts, err := db.GetBucketList(ssPath); err != nil || len(bkts) != 0 {
+			t.Errorf("buckets after DeleteBucket = %v, %v", bkts, err)
+		}
+		if _, err := db.GetValue(deep, "imagehash"); err == nil {
+			t.Error("GetValue from a deleted bucket didn't fail")
+		}
+		// Deleting what isn't there is fine, as long as the path is
+		if err := db.DeleteBucket(ssPath, "s1"); err != nil {
+			t.Errorf("DeleteBucket of a missing bucket: %v", err)
+		}
+		if err := db.DeleteBucket([]string{"jam", "votes"}, "v1"); err == nil {
+			t.Error("DeleteBucket on a missing path didn't fail")
+		}
+	})
+}
+
+func TestStoreUpdate(t *testing.T) {
+	forEachStorage(t, func(t *testing.T, st Storage) {
+		db := openTestStore(t, st)
+		pth := []string{"jam", "votes", "cl1", "ts1"}
+		db.SetValue(pth, "1", "team-a")
+
+		failed := errors.New("failed")
+		err := db.Update(func(tx Store) error {
+			if err := tx.SetValue(pth, "1", "team-b"); err != nil {
+				return err
+			}
+			if err := tx.SetValue(append(pth, "categories", "c1"), "1", "team-b"); err != nil {
+				return err
+			}
+			return failed
+		})
+		if err != failed {
+			t.Fatalf("Update = %v, want %v", err, failed)
+		}
+		if v, _ := db.GetValue(pth, "1"); v != "team-a" {
+			t.Errorf("value after a failed Update = %q, want team-a", v)
+		}
+		if bkts, _ := db.GetBucketList(pth); len(bkts) != 0 {
+			t.Errorf("buckets after a failed Update = %v", bkts)
+		}
+
+		err = db.Update(func(tx Store) error {
+			if err := tx.SetValue(pth, "1", "team-b"); err != nil {
+				return err
+			}
+			if v, err := tx.GetValue(pth, "1"); err != nil || v != "team-b" {
+				t.Errorf("GetValue in Update = %q, %v", v, err)
+			}
+			if err := tx.SetValue(append(pth, "categories", "c1"), "1", "team-b"); err != nil {
+				return err
+			}
+			return tx.DeletePair(pth, "1")
+		})
+		if err != nil {
+			t.Fatalf("Update: %v", err)
+		}
+		if keys, _ := db.GetKeyList(pth); len(keys) != 0 {
+			t.Errorf("keys after Update = %v", keys)
+		}
+		if v, _ := db.GetValue(append(pth, "categories", "c1"), "1"); v != "team-b" {
+			t.Errorf("value after Update = %q, want team-b", v)
+		}
+	})
+}
+
+func TestStorageDBs(t *testing.T) {
+	forEachStorage(t, func(t *testing.T, st Storage) {
+		for _, name := range []string{"b.db", "a.db"} {
+			db, err := st.Open(name)
+			if err != nil {
+				t.Fatalf("Open: %v", err)
+			}
+			db.SetValue([]string{"site"}, "name", name)
+			db.CloseDB()
+		}
+		if names, err := st.List(); err != nil || !reflect.DeepEqual(names, []string{"a.db", "b.db"}) {
+			t.Errorf("List = %v, %v", names, err)
+		}
+		if _, err := st.ModTime("a.db"); err != nil {
+			t.Errorf("ModTime: %v", err)
+		}
+
+		if err := st.Rename("a.db", "b.db"); err != nil {
+			t.Fatalf("Rename: %v", err)
+		}
+		if st.Exists("a.db") {
+			t.Error("a.db still exists after Rename")
+		}
+		db, _ := st.Open("b.db")
+		if v, _ := db.GetValue([]string{"site"}, "name"); v != "a.db" {
+			t.Errorf("b.db after Rename has %q, want a.db", v)
+		}
+		db.CloseDB()
+
+		// Snapshots are bolt files whichever backend wrote them
+		if raceEnabled {
+			t.Skip(boltRaceSkip)
+		}
+		dir, cleanup := tempDir(t)
+		defer cleanup()
+		snap := filepath.Join(dir, "snap.db")
+		if err := st.Snapshot("b.db", snap); err != nil {
+			t.Fatalf("Snapshot: %v", err)
+		}
+		if err := st.Remove("b.db"); err != nil {
+			t.Fatalf("Remove: %v", err)
+		}
+		if st.Exists("b.db") {
+			t.Error("b.db still exists after Remove")
+		}
+		if err := st.Restore("c.db", snap); err != nil {
+			t.Fatalf("Restore: %v", err)
+		}
+		db, _ = st.Open("c.db")
+		if v, _ := db.GetValue([]string{"site"}, "name"); v != "a.db" {
+			t.Errorf("restored DB has %q, want a.db", v)
+		}
+		db.CloseDB()
+	})
+}
@@ -0,0 +1,484 @@
+package main
+
+import "sort"
+
+type Ranking struct {
+	Rank     int
+	Teams    []Team
+	TieBreak string // Name of the tie-break that placed these teams, if one was needed
+}
+
+// A TallyMethod turns the votes cast in a jam into a ranking of its teams
+type TallyMethod interface {
+	Name() string
+	Tally(teams []Team, votes []Vote) []Ranking
+}
+
+// Tally Methods: The keys that are stored on a Gamejam to select how it is tallied
+const (
+	TallyCondorcet     = "condorcet"
+	TallyCopeland      = "copeland"
+	TallySchulze       = "schulze"
+	TallyRankedPairs   = "rankedpairs"
+	TallyBorda         = "borda"
+	TallyInstantRunoff = "instantrunoff"
+)
+
+// tallyMethodKeys is the order that tally methods are offered to admins
+var tallyMethodKeys = []string{
+	TallyCondorcet,
+	TallyCopeland,
+	TallySchulze,
+	TallyRankedPairs,
+	TallyBorda,
+	TallyInstantRunoff,
+}
+
+var tallyMethods = map[string]TallyMethod{
+	TallyCondorcet:     condorcetTally{},
+	TallyCopeland:      copelandTally{},
+	TallySchulze:       schulzeTally{},
+	TallyRankedPairs:   rankedPairsTally{},
+	TallyBorda:         bordaTally{},
+	TallyInstantRunoff: instantRunoffTally{},
+}
+
+// getTallyMethod returns the tally method for the given key
+// Unknown (or empty) keys get the Condorcet method
+func getTallyMethod(key string) TallyMethod {
+	if tm, ok := tallyMethods[key]; ok {
+		return tm
+	}
+	return tallyMethods[TallyCondorcet]
+}
+
+// isValidTallyMethod returns whether key is a known tally method
+func isValidTallyMethod(key string) bool {
+	_, ok := tallyMethods[key]
+	return ok
+}
+
+// GetResults tallies the jam's votes with the jam's tally method
+// then settles any ties with the jam's tie-breaks
+func (gj *Gamejam) GetResults() []Ranking {
+	res := getTallyMethod(gj.TallyMethod).Tally(gj.Teams, gj.Votes)
+	return applyTieBreaks(res, gj.Teams, gj.Votes, gj.TieBreaks, gj.CoinFlips)
+}
+
+// GetTallyMethodName returns the display name of the jam's tally method
+func (gj *Gamejam) GetTallyMethodName() string {
+	return getTallyMethod(gj.TallyMethod).Name()
+}
+
+/**
+ * Condorcet
+ */
+type condorcetTally struct{}
+
+func (t condorcetTally) Name() string { return "Condorcet" }
+
+func (t condorcetTally) Tally(teams []Team, votes []Vote) []Ranking {
+	return getCondorcetResult(teams, votes)
+}
+
+// getCondorcetResult returns the ranking of teams based on the condorcet method
+// https://en.wikipedia.org/wiki/Condorcet_method
+// Teams are ranked by the number of head-to-head matchups that they win
+func getCondorcetResult(teams []Team, votes []Vote) []Ranking {
+	pm := NewPairwiseMatrix(teams, votes)
+	wins := make([]float64, len(teams))
+	for i := range teams {
+		for j := range teams {
+			if pm.Beats(i, j) {
+				wins[i]++
+			}
+		}
+	}
+	return rankByScore(teams, wins)
+}
+
+// This is a helper function for calculating results
+func uuidIsInRankingSlice(uuid string, sl []Ranking) bool {
+	for _, v := range sl {
+		for i := range v.Teams {
+			if v.Teams[i].UUID == uuid {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+/**
+ * Copeland
+ * https://en.wikipedia.org/wiki/Copeland%27s_method
+ * Each team scores a point for every pairwise win and loses one for every loss
+ */
+type copelandTally struct{}
+
+func (t copelandTally) Name() string { return "Copeland" }
+
+func (t copelandTally) Tally(teams []Team, votes []Vote) []Ranking {
+	pm := NewPairwiseMatrix(teams, votes)
+	scores := make([]float64, len(teams))
+	for i := range teams {
+		for j := range teams {
+			if pm.Beats(i, j) {
+				scores[i]++
+			} else if pm.Beats(j, i) {
+				scores[i]--
+			}
+		}
+	}
+	return rankByScore(teams, scores)
+}
+
+/**
+ * Schulze
+ * https://en.wikipedia.org/wiki/Schulze_method
+ */
+type schulzeTally struct{}
+
+func (t schulzeTally) Name() string { return "Schulze" }
+
+func (t schulzeTally) Tally(teams []Team, votes []Vote) []Ranking {
+	p := NewPairwiseMatrix(teams, votes).SchulzeStrengths()
+	// The strongest paths give a transitive ordering, so ranking by the
+	// number of teams each team beats gives the Schulze ranking
+	scores := make([]float64, len(teams))
+	for i := range teams {
+		for j := range teams {
+			if i != j && p[i][j] > p[j][i] {
+				scores[i]++
+			}
+		}
+	}
+	return rankByScore(teams, scores)
+}
+
+// SchulzeStrengths returns p, where p[i][j] is the strength of the strongest
+// path from Teams[i] to Teams[j]
+func (pm *PairwiseMatrix) SchulzeStrengths() [][]int {
+	d := pm.prefs
+	n := len(d)
+	p := make([][]int, n)
+	for i := range p {
+		p[i] = make([]int, n)
+		for j := range p[i] {
+			if i != j && d[i][j] > d[j][i] {
+				p[i][j] = d[i][j]
+			}
+		}
+	}
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if i == j {
+				continue
+			}
+			for k := 0; k < n; k++ {
+				if i == k || j == k {
+					continue
+				}
+				if s := minInt(p[j][i], p[i][k]); s > p[j][k] {
+					p[j][k] = s
+				}
+			}
+		}
+	}
+	return p
+}
+
+/**
+ * Ranked Pairs (Tideman)
+ * https://en.wikipedia.org/wiki/Ranked_pairs
+ */
+type rankedPairsTally struct{}
+
+func (t rankedPairsTally) Name() string { return "Ranked Pairs" }
+
+func (t rankedPairsTally) Tally(teams []Team, votes []Vote) []Ranking {
+	d := NewPairwiseMatrix(teams, votes).prefs
+	type pair struct {
+		winner, loser int
+	}
+	var pairs []pair
+	for i := range teams {
+		for j := range teams {
+			if d[i][j] > d[j][i] {
+				pairs = append(pairs, pair{winner: i, loser: j})
+			}
+		}
+	}
+	// Strongest victories first, larger majorities are locked in before smaller ones
+	sort.SliceStable(pairs, func(a, b int) bool {
+		pa, pb := pairs[a], pairs[b]
+		if d[pa.winner][pa.loser] != d[pb.winner][pb.loser] {
+			return d[pa.winner][pa.loser] > d[pb.winner][pb.loser]
+		}
+		return d[pa.loser][pa.winner] < d[pb.loser][pb.winner]
+	})
+	locked := make([][]bool, len(teams))
+	for i := range locked {
+		locked[i] = make([]bool, len(teams))
+	}
+	for _, p := range pairs {
+		// Only lock this pair in if it doesn't create a cycle
+		if !pathExists(locked, p.loser, p.winner) {
+			locked[p.winner][p.loser] = true
+		}
+	}
+
+	// Teams with nothing locked over them (among the teams left) are next
+	var ret []Ranking
+	remaining := make(map[int]bool)
+	for i := range teams {
+		remaining[i] = true
+	}
+	currRank := 1
+	for len(remaining) > 0 {
+		var top []int
+		for i := range teams {
+			if !remaining[i] {
+				continue
+			}
+			beaten := false
+			for j := range remaining {
+				if locked[j][i] {
+					beaten = true
+					break
+				}
+			}
+			if !beaten {
+				top = append(top, i)
+			}
+		}
+		nR := Ranking{Rank: currRank}
+		for _, i := range top {
+			nR.Teams = append(nR.Teams, teams[i])
+			delete(remaining, i)
+		}
+		ret = append(ret, nR)
+		currRank += len(top)
+	}
+	return ret
+}
+
+// pathExists returns whether there is a path from 'from' to 'to' in the graph g
+func pathExists(g [][]bool, from, to int) bool {
+	visited := make([]bool, len(g))
+	stack := []int{from}
+	for len(stack) > 0 {
+		n := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if n == to {
+			return true
+		}
+		if visited[n] {
+			continue
+		}
+		visited[n] = true
+		for k := range g[n] {
+			if g[n][k] && !visited[k] {
+				stack = append(stack, k)
+			}
+		}
+	}
+	return false
+}
+
+/**
+ * Borda Count
+ * https://en.wikipedia.org/wiki/Borda_count
+ * With n teams, a first choice is worth n-1 points, second n-2 and so on.
+ * Unranked teams are tied for last and get no points.
+ */
+type bordaTally struct{}
+
+func (t bordaTally) Name() string { return "Borda Count" }
+
+func (t bordaTally) Tally(teams []Team, votes []Vote) []Ranking {
+	idx := teamIndexes(teams)
+	scores := make([]float64, len(teams))
+	for _, v := range votes {
+		for pos, tmId := range ballotOrder(v) {
+			if i, ok := idx[tmId]; ok {
+				scores[i] += float64(len(teams) - 1 - pos)
+			}
+		}
+	}
+	return rankByScore(teams, scores)
+}
+
+/**
+ * Instant Runoff
+ */
+type instantRunoffTally struct{}
+
+func (t instantRunoffTally) Name() string { return "Instant Runoff" }
+
+func (t instantRunoffTally) Tally(teams []Team, votes []Vote) []Ranking {
+	ret, _ := getInstantRunoffResult(teams, votes)
+	return ret
+}
+
+// RunoffTally is the number of ballots a team held in a runoff round
+type RunoffTally struct {
+	Team  Team
+	Votes int
+}
+
+// RunoffRound is a single round of an instant-runoff count
+type RunoffRound struct {
+	Round      int
+	Tallies    []RunoffTally
+	Exhausted  int // Ballots with no remaining ranked teams
+	Eliminated []Team
+}
+
+// getInstantRunoffResult returns the ranking of teams based on instant-runoff voting
+// along with the data for each round of the count.
+// https://en.wikipedia.org/wiki/Instant-runoff_voting
+// Each round every ballot counts for its highest ranked team that is still in the
+// running, ballots that don't rank any remaining teams are exhausted. The team(s)
+// with the fewest ballots are eliminated, until no teams are left. Teams are ranked
+// in reverse order of elimination, teams eliminated in the same round share a rank.
+func getInstantRunoffResult(teams []Team, votes []Vote) ([]Ranking, []RunoffRound) {
+	var rounds []RunoffRound
+	var eliminations [][]Team
+	remaining := make(map[string]bool)
+	for i := range teams {
+		remaining[teams[i].UUID] = true
+	}
+	for len(remaining) > 0 {
+		rnd := new(RunoffRound)
+		rnd.Round = len(rounds) + 1
+		counts := make(map[string]int)
+		for _, v := range votes {
+			if tmId := topRemainingChoice(v, remaining); tmId != "" {
+				counts[tmId]++
+			} else {
+				rnd.Exhausted++
+			}
+		}
+		fewest := -1
+		for i := range teams {
+			tm := teams[i]
+			if !remaining[tm.UUID] {
+				continue
+			}
+			rnd.Tallies = append(rnd.Tallies, RunoffTally{Team: tm, Votes: counts[tm.UUID]})
+			if fewest == -1 || counts[tm.UUID] < fewest {
+				fewest = counts[tm.UUID]
+			}
+		}
+		sort.SliceStable(rnd.Tallies, func(i, j int) bool {
+			return rnd.Tallies[i].Votes > rnd.Tallies[j].Votes
+		})
+		for _, t := range rnd.Tallies {
+			if t.Votes == fewest {
+				rnd.Eliminated = append(rnd.Eliminated, t.Team)
+				delete(remaining, t.Team.UUID)
+			}
+		}
+		eliminations = append(eliminations, rnd.Eliminated)
+		rounds = append(rounds, *rnd)
+	}
+
+	// The last teams eliminated are the winners
+	var ret []Ranking
+	currRank := 1
+	for i := len(eliminations) - 1; i >= 0; i-- {
+		ret = append(ret, Ranking{Rank: currRank, Teams: eliminations[i]})
+		currRank += len(eliminations[i])
+	}
+	return ret, rounds
+}
+
+// topRemainingChoice returns the UUID of the highest ranked team on the vote that
+// is in remaining, or an empty string if the vote doesn't rank any of them
+func topRemainingChoice(vt Vote, remaining map[string]bool) string {
+	top := -1
+	for i, chc := range vt.Choices {
+		if !remaining[chc.Team] {
+			continue
+		}
+		if top == -1 || chc.Rank < vt.Choices[top].Rank {
+			top = i
+		}
+	}
+	if top == -1 {
+		return ""
+	}
+	return vt.Choices[top].Team
+}
+
+/**
+ * Helpers
+ */
+
+// ballotOrder returns the team ids on a vote from most to least preferred
+// Empty choices and repeat choices of the same team are skipped
+func ballotOrder(vt Vote) []string {
+	chcs := make([]GameChoice, len(vt.Choices))
+	copy(chcs, vt.Choices)
+	sort.SliceStable(chcs, func(i, j int) bool {
+		return chcs[i].Rank < chcs[j].Rank
+	})
+	var ret []string
+	seen := make(map[string]bool)
+	for _, chc := range chcs {
+		if chc.Team == "" || seen[chc.Team] {
+			continue
+		}
+		seen[chc.Team] = true
+		ret = append(ret, chc.Team)
+	}
+	return ret
+}
+
+// rankByScore ranks the teams from highest score to lowest
+// scores[i] is the score for teams[i], teams with equal scores share a rank
+func rankByScore(teams []Team, scores []float64) []Ranking {
+	order := make([]int, len(teams))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return scores[order[a]] > scores[order[b]]
+	})
+	var ret []Ranking
+	for pos, i := range order {
+		if pos > 0 && scores[i] == scores[order[pos-1]] {
+			ret[len(ret)-1].Teams = append(ret[len(ret)-1].Teams, teams[i])
+			continue
+		}
+		ret = append(ret, Ranking{Rank: pos + 1, Teams: []Team{teams[i]}})
+	}
+	return ret
+}
+
+// teamIndexes returns a map of team UUIDs to their index in teams
+func teamIndexes(teams []Team) map[string]int {
+	ret := make(map[string]int)
+	for i := range teams {
+		ret[teams[i].UUID] = i
+	}
+	return ret
+}
+
+// findTeam returns the team in teams with the given UUID, or nil
+func findTeam(teams []Team, id string) *Team {
+	for i := range teams {
+		if teams[i].UUID == id {
+			return &teams[i]
+		}
+	}
+	return nil
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -3,6 +3,7 @@ package main
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/pborman/uuid"
 )
@@ -48,6 +49,7 @@ type TeamMember struct {
 	SlackId string
 	Twitter string
 	Email   string
+	Public  bool // Whether they want to be listed on the public archive pages
 
 	mPath []string // The path in the DB to this team member
 }
@@ -104,7 +106,7 @@ func (tm *Team) RemoveTeamMemberById(id string) error {
 
 /**
  * DB Functions
- * These are generally just called when the app starts up, or when the periodic 'save' runs
+ * Teams are loaded when the app starts up, and saved as soon as they change
  */
 
 // LoadAllTeams loads all teams for the jam out of the database
@@ -118,7 +120,7 @@ func (gj *Gamejam) LoadAllTeams() []Team {
 
 	var tmUUIDs []string
 	tmsPath := append(gj.mPath, "teams")
-	if tmUUIDs, err = gj.m.bolt.GetBucketList(tmsPath); err != nil {
+	if tmUUIDs, err = gj.m.db.GetBucketList(tmsPath); err != nil {
 		fmt.Println(err.Error())
 		return ret
 	}
@@ -141,7 +143,7 @@ func (gj *Gamejam) LoadTeam(uuid string) (*Team, error) {
 
 	// Team Data
 	tm := NewTeam(uuid)
-	if tm.Name, err = gj.m.bolt.GetValue(tm.mPath, "name"); err != nil {
+	if tm.Name, err = gj.m.db.GetValue(tm.mPath, "name"); err != nil {
 		return nil, errors.New("Error loading team: " + err.Error())
 	}
 
@@ -169,7 +171,7 @@ func (gj *Gamejam) LoadTeamMembers(tmId string) []TeamMember {
 	var memberUuids []string
 	tm := NewTeam(tmId)
 	mbrsPath := append(tm.mPath, "members")
-	if memberUuids, err = gj.m.bolt.GetBucketList(mbrsPath); err == nil {
+	if memberUuids, err = gj.m.db.GetBucketList(mbrsPath); err == nil {
 		for _, v := range memberUuids {
 			mbr, _ := gj.LoadTeamMember(tmId, v)
 			if mbr != nil {
@@ -193,21 +195,25 @@ func (gj *Gamejam) LoadTeamMember(tmId, mbrId string) (*TeamMember, error) {
 		return nil, errors.New("Error loading team member: " + err.Error())
 	}
 	// Name is the only required field
-	if mbr.Name, err = gj.m.bolt.GetValue(mbr.mPath, "name"); err != nil {
+	if mbr.Name, err = gj.m.db.GetValue(mbr.mPath, "name"); err != nil {
 		return nil, errors.New("Error loading team member: " + err.Error())
 	}
-	if mbr.SlackId, err = gj.m.bolt.GetValue(mbr.mPath, "slackid"); err != nil {
+	if mbr.SlackId, err = gj.m.db.GetValue(mbr.mPath, "slackid"); err != nil {
 		mbr.SlackId = ""
 	}
-	if mbr.Twitter, err = gj.m.bolt.GetValue(mbr.mPath, "twitter"); err != nil {
+	if mbr.Twitter, err = gj.m.db.GetValue(mbr.mPath, "twitter"); err != nil {
 		mbr.Twitter = ""
 	}
-	if mbr.Email, err = gj.m.bolt.GetValue(mbr.mPath, "email"); err != nil {
+	if mbr.Email, err = gj.m.db.GetValue(mbr.mPath, "email"); err != nil {
 		mbr.Email = ""
 	}
+	if mbr.Public, err = gj.m.db.GetBool(mbr.mPath, "public"); err != nil {
+		mbr.Public = false
+	}
 	return mbr, nil
 }
 
+// SaveTeam writes the team, its members and its game in one transaction
 func (gj *Gamejam) SaveTeam(tm *Team) error {
 	var err error
 	if err = gj.m.openDB(); err != nil {
@@ -215,35 +221,59 @@ func (gj *Gamejam) SaveTeam(tm *Team) error {
 	}
 	defer gj.m.closeDB()
 
+	return gj.m.db.Update(func(tx Store) error {
+		return gj.saveTeam(tx, tm)
+	})
+}
+
+func (gj *Gamejam) saveTeam(tx Store, tm *Team) error {
+	var err error
 	// Save team data
-	if err = gj.m.bolt.SetValue(tm.mPath, "name", tm.Name); err != nil {
+	if err = tx.SetValue(tm.mPath, "name", tm.Name); err != nil {
 		return err
 	}
 
 	// Save team members
 	for _, mbr := range tm.Members {
-		if err = gj.m.bolt.SetValue(mbr.mPath, "name", mbr.Name); err != nil {
+		if err = tx.SetValue(mbr.mPath, "name", mbr.Name); err != nil {
 			return err
 		}
-		if err = gj.m.bolt.SetValue(mbr.mPath, "slackid", mbr.SlackId); err != nil {
+		if err = tx.SetValue(mbr.mPath, "slackid", mbr.SlackId); err != nil {
 			return err
 		}
-		if err = gj.m.bolt.SetValue(mbr.mPath, "twitter", mbr.Twitter); err != nil {
+		if err = tx.SetValue(mbr.mPath, "twitter", mbr.Twitter); err != nil {
 			return err
 		}
-		if err = gj.m.bolt.SetValue(mbr.mPath, "email", mbr.Email); err != nil {
+		if err = tx.SetValue(mbr.mPath, "email", mbr.Email); err != nil {
 			return err
 		}
+		if err = tx.SetBool(mbr.mPath, "public", mbr.Public); err != nil {
+			return err
+		}
+	}
+	// Remove members from the DB that aren't on the team anymore
+	var mbrIds []string
+	if mbrIds, err = tx.GetBucketList(append(tm.mPath, "members")); err == nil {
+		for _, id := range mbrIds {
+			if _, err = tm.GetTeamMemberById(id); err == nil {
+				continue
+			}
+			var mbr *TeamMember
+			if mbr, err = NewTeamMember(tm.UUID, id); err != nil {
+				return err
+			}
+			if err = deleteTeamMember(tx, mbr); err != nil {
+				return err
+			}
+		}
 	}
 
 	// Save team game
 	fmt.Println("> Saving game " + tm.Game.Name + " data to DB")
-	return gj.SaveGame(tm.Game)
+	return gj.saveGame(tx, tm.Game)
 }
 
-// Delete the team tm
-// TODO: Deletes should be done all at once when syncing memory to the DB
-/*
+// Delete the team tm from the DB
 func (gj *Gamejam) DeleteTeam(tm *Team) error {
 	var err error
 	if err = gj.m.openDB(); err != nil {
@@ -252,15 +282,12 @@ func (gj *Gamejam) DeleteTeam(tm *Team) error {
 	defer gj.m.closeDB()
 
 	if len(tm.mPath) < 2 {
-		return errors.New("Invalid team path: " + string(tm.mPath))
+		return errors.New("Invalid team path: " + strings.Join(tm.mPath, "/"))
 	}
-	return gj.m.bolt.DeleteBucket(tm.mPath[:len(tm.mPath)-1], tm.UUID)
+	return gj.m.db.DeleteBucket(tm.mPath[:len(tm.mPath)-1], tm.UUID)
 }
-*/
 
-// Delete the TeamMember mbr from Team tm
-// TODO: Deletes should be done all at once when syncing memory to the DB
-/*
+// Delete the TeamMember mbr of Team tm from the DB
 func (gj *Gamejam) DeleteTeamMember(tm *Team, mbr *TeamMember) error {
 	var err error
 	if err = gj.m.openDB(); err != nil {
@@ -268,12 +295,38 @@ func (gj *Gamejam) DeleteTeamMember(tm *Team, mbr *TeamMember) error {
 	}
 	defer gj.m.closeDB()
 
+	return deleteTeamMember(gj.m.db, mbr)
+}
+
+func deleteTeamMember(tx Store, mbr *TeamMember) error {
 	if len(mbr.mPath) < 2 {
-		return errors.New("Invalid team path: " + string(tm.mPath))
+		return errors.New("Invalid team member path: " + strings.Join(mbr.mPath, "/"))
 	}
-	return gj.m.bolt.DeleteBucket(mbr.mPath[:len(mbr.mPath)-1], mbr.UUID)
+	return tx.DeleteBucket(mbr.mPath[:len(mbr.mPath)-1], mbr.UUID)
+}
+
+// deleteStaleTeams removes teams from the DB that aren't in the jam anymore
+func (gj *Gamejam) deleteStaleTeams() error {
+	var err error
+	if err = gj.m.openDB(); err != nil {
+		return err
+	}
+	defer gj.m.closeDB()
+
+	var tmIds []string
+	if tmIds, err = gj.m.db.GetBucketList(append(gj.mPath, "teams")); err != nil {
+		return nil
+	}
+	for _, id := range tmIds {
+		if _, err = gj.GetTeamById(id); err == nil {
+			continue
+		}
+		if err = gj.DeleteTeam(NewTeam(id)); err != nil {
+			return err
+		}
+	}
+	return nil
 }
-*/
 
 /**
  * In Memory functions
@@ -289,6 +342,20 @@ func (gj *Gamejam) AddTeam(tm *Team) error {
 		return errors.New("A team with that Name already exists")
 	}
 	gj.Teams = append(gj.Teams, *tm)
+	if err := gj.SaveTeam(tm); err != nil {
+		gj.Teams = gj.Teams[:len(gj.Teams)-1]
+		return errors.New("Error saving team: " + err.Error())
+	}
+	gj.m.publishEvent(EventTeam)
+	return nil
+}
+
+// UpdateTeam saves the changes that were made to a team
+func (gj *Gamejam) UpdateTeam(tm *Team) error {
+	if err := gj.SaveTeam(tm); err != nil {
+		return errors.New("Error saving team: " + err.Error())
+	}
+	gj.m.publishEvent(EventTeam)
 	return nil
 }
 
@@ -314,6 +381,13 @@ func (gj *Gamejam) RemoveTeamById(id string) error {
 	if idx == -1 {
 		return errors.New("Invalid Team ID given")
 	}
+	if err := gj.m.backupBefore("delete-team"); err != nil {
+		return err
+	}
+	if err := gj.DeleteTeam(&gj.Teams[idx]); err != nil {
+		return errors.New("Error deleting team: " + err.Error())
+	}
 	gj.Teams = append(gj.Teams[:idx], gj.Teams[idx+1:]...)
+	gj.m.publishEvent(EventTeam)
 	return nil
 }
@@ -0,0 +1,182 @@
+package main
+
+import (
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/gorilla/sessions"
+)
+
+// newTestModel sets m to a model kept in st, with a few teams and voting
+// open to every client
+func newTestModel(t *testing.T, st Storage) []string {
+	t.Helper()
+	var err error
+	if m, err = NewModelWithStorage(st); err != nil {
+		t.Fatalf("Error making model: %v", err)
+	}
+	sessionStore = sessions.NewCookieStore([]byte("test session secret"))
+	deviceCookie = newDeviceCookie("test session secret")
+
+	var tmIds []string
+	for i := 0; i < 3; i++ {
+		tm := NewTeam("")
+		tm.Name = "Team " + strconv.Itoa(i)
+		if err = m.jam.AddTeam(tm); err != nil {
+			t.Fatalf("Error adding team: %v", err)
+		}
+		tmIds = append(tmIds, tm.UUID)
+	}
+	m.site.SetAuthMode(AuthModeAll)
+	m.site.SetPublicMode(SiteModeVoting)
+	// An open voting window, so checkVotingWindow has something to do
+	m.jam.VotingOpens = time.Now().Add(-time.Hour)
+	m.jam.VotingCloses = time.Now().Add(time.Hour)
+	return tmIds
+}
+
+// runTestBackground does what the scheduler and a new live event subscriber
+// do, over and over until done is closed
+func runTestBackground(done chan struct{}, wg *sync.WaitGroup) {
+	defer wg.Done()
+	for {
+		select {
+		case <-done:
+			return
+		default:
+		}
+		m.mu.Lock()
+		m.checkVotingWindow(time.Now())
+		m.liveStatus(EventStatus)
+		m.mu.Unlock()
+		time.Sleep(time.Millisecond)
+	}
+}
+
+// Run with -race, votes and client changes coming in while the scheduler
+// and live events run shouldn't touch the model without the lock
+func TestConcurrentModelChanges(t *testing.T) {
+	st := NewMemStorage()
+	tmIds := newTestModel(t, st)
+	const voters = 20
+
+	var bg, wg sync.WaitGroup
+	done := make(chan struct{})
+	bg.Add(1)
+	go runTestBackground(done, &bg)
+	for i := 0; i < voters; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			m.mu.Lock()
+			defer m.mu.Unlock()
+			cl := NewClient("client-" + strconv.Itoa(i))
+			cl.Name = "Kiosk " + strconv.Itoa(i)
+			cl.Auth = true
+			if err := m.UpdateClient(cl); err != nil {
+				t.Errorf("UpdateClient: %v", err)
+				return
+			}
+			vt, err := NewVote(cl.UUID, time.Now())
+			if err != nil {
+				t.Errorf("NewVote: %v", err)
+				return
+			}
+			vt.SetChoices(tmIds)
+			if err = m.jam.AddVote(vt); err != nil {
+				t.Errorf("AddVote: %v", err)
+			}
+		}(i)
+	}
+	wg.Wait()
+	close(done)
+	bg.Wait()
+
+	if len(m.jam.Votes) != voters {
+		t.Errorf("Got %d votes, want %d", len(m.jam.Votes), voters)
+	}
+	if len(m.clients) != voters {
+		t.Errorf("Got %d clients, want %d", len(m.clients), voters)
+	}
+	// And they all made it to the DB
+	saved, err := NewModelWithStorage(st)
+	if err != nil {
+		t.Fatalf("Error reloading model: %v", err)
+	}
+	if len(saved.jam.Votes) != voters {
+		t.Errorf("Reloaded %d votes, want %d", len(saved.jam.Votes), voters)
+	}
+	if len(saved.clients) != voters {
+		t.Errorf("Reloaded %d clients, want %d", len(saved.clients), voters)
+	}
+}
+
+// Run with -race, ballots from many kiosks at once go through lockHandler
+// one at a time
+func TestConcurrentRequests(t *testing.T) {
+	tmIds := newTestModel(t, NewMemStorage())
+	const voters = 20
+
+	rtr := mux.NewRouter()
+	pub := rtr.PathPrefix("/").Subrouter()
+	pub.Use(lockHandler)
+	pub.HandleFunc("/", handleMain)
+	pub.HandleFunc("/{function}", handleMain)
+	srv := httptest.NewServer(rtr)
+	defer srv.Close()
+
+	var bg, wg sync.WaitGroup
+	done := make(chan struct{})
+	bg.Add(1)
+	go runTestBackground(done, &bg)
+	for i := 0; i < voters; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			jar, _ := cookiejar.New(nil)
+			cl := &http.Client{Jar: jar}
+			// The voting page gives the kiosk its device cookie
+			resp, err := cl.Get(srv.URL + "/")
+			if err != nil {
+				t.Errorf("Error loading voting page: %v", err)
+				return
+			}
+			resp.Body.Close()
+			resp, err = cl.PostForm(srv.URL+"/vote", url.Values{
+				"timestamp":   {time.Now().Format(time.RFC3339)},
+				"uservote":    {strings.Join(tmIds, ",")},
+				"voterstatus": {"visitor"},
+			})
+			if err != nil {
+				t.Errorf("Error voting: %v", err)
+				return
+			}
+			resp.Body.Close()
+			if resp.StatusCode != http.StatusOK {
+				t.Errorf("Voting returned %s", resp.Status)
+			}
+		}()
+	}
+	wg.Wait()
+	close(done)
+	bg.Wait()
+
+	if len(m.jam.Votes) != voters {
+		t.Errorf("Got %d votes, want %d", len(m.jam.Votes), voters)
+	}
+	seen := make(map[string]bool)
+	for _, vt := range m.jam.Votes {
+		seen[vt.ClientId] = true
+	}
+	if len(seen) != voters {
+		t.Errorf("Votes came from %d clients, want %d", len(seen), voters)
+	}
+}
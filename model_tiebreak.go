@@ -0,0 +1,212 @@
+package main
+
+import "strings"
+
+// A TieBreaker separates teams that a tally method left tied
+// Teams with a higher score are placed ahead, equal scores stay tied
+type TieBreaker struct {
+	Key   string
+	Name  string
+	score func(ctx *tieBreakContext, tied []Team) []float64
+}
+
+// tieBreakContext is everything a tie-breaker can use to score tied teams
+type tieBreakContext struct {
+	teams     []Team
+	votes     []Vote
+	pm        *PairwiseMatrix
+	coinFlips []string
+}
+
+// Tie-breaks: The keys that are stored on a Gamejam to build its tie-break chain
+const (
+	TieBreakSchulze     = "schulze"
+	TieBreakFirstChoice = "firstchoice"
+	TieBreakCoinFlip    = "coinflip"
+)
+
+// tieBreakerKeys is the order that tie-breakers are offered to admins
+var tieBreakerKeys = []string{
+	TieBreakSchulze,
+	TieBreakFirstChoice,
+	TieBreakCoinFlip,
+}
+
+var tieBreakers = map[string]TieBreaker{
+	TieBreakSchulze:     {Key: TieBreakSchulze, Name: "Schulze strength", score: schulzeTieBreak},
+	TieBreakFirstChoice: {Key: TieBreakFirstChoice, Name: "First-choice votes", score: firstChoiceTieBreak},
+	TieBreakCoinFlip:    {Key: TieBreakCoinFlip, Name: "Coin flip", score: coinFlipTieBreak},
+}
+
+// defaultTieBreaks is the tie-break chain for jams that haven't picked one
+var defaultTieBreaks = []string{TieBreakSchulze, TieBreakFirstChoice, TieBreakCoinFlip}
+
+// isValidTieBreaker returns whether key is a known tie-breaker
+func isValidTieBreaker(key string) bool {
+	_, ok := tieBreakers[key]
+	return ok
+}
+
+// getTieBreakerName returns the display name for a tie-breaker key
+func getTieBreakerName(key string) string {
+	if tb, ok := tieBreakers[key]; ok {
+		return tb.Name
+	}
+	return ""
+}
+
+// cleanTieBreaks returns the valid keys of chain, in order, without repeats
+func cleanTieBreaks(chain []string) []string {
+	ret := []string{}
+	seen := make(map[string]bool)
+	for _, k := range chain {
+		k = strings.TrimSpace(k)
+		if isValidTieBreaker(k) && !seen[k] {
+			seen[k] = true
+			ret = append(ret, k)
+		}
+	}
+	return ret
+}
+
+// applyTieBreaks runs each tie-breaker in chain, in order, over every ranking
+// that holds more than one team. Each tie-breaker only splits the groups that
+// the ones before it left tied. Ranks are numbered so that tied teams share a
+// rank and the next rank skips the places they took (1, 1, 3).
+func applyTieBreaks(rankings []Ranking, teams []Team, votes []Vote, chain []string, coinFlips []string) []Ranking {
+	ctx := &tieBreakContext{
+		teams:     teams,
+		votes:     votes,
+		pm:        NewPairwiseMatrix(teams, votes),
+		coinFlips: coinFlips,
+	}
+	var ret []Ranking
+	place := 1
+	for _, r := range rankings {
+		groups := []Ranking{{Teams: r.Teams, TieBreak: r.TieBreak}}
+		for _, k := range chain {
+			tb, ok := tieBreakers[k]
+			if !ok {
+				continue
+			}
+			var split []Ranking
+			for _, g := range groups {
+				if len(g.Teams) < 2 {
+					split = append(split, g)
+					continue
+				}
+				sub := rankByScore(g.Teams, tb.score(ctx, g.Teams))
+				if len(sub) == 1 {
+					// This tie-breaker couldn't separate them
+					split = append(split, g)
+					continue
+				}
+				for i := range sub {
+					sub[i].TieBreak = tb.Name
+				}
+				split = append(split, sub...)
+			}
+			groups = split
+		}
+		for _, g := range groups {
+			g.Rank = place
+			place += len(g.Teams)
+			ret = append(ret, g)
+		}
+	}
+	return ret
+}
+
+// schulzeTieBreak scores each tied team by how many of the other tied teams
+// it beats on strongest path strength
+func schulzeTieBreak(ctx *tieBreakContext, tied []Team) []float64 {
+	p := ctx.pm.SchulzeStrengths()
+	idx := teamIndexes(ctx.teams)
+	ret := make([]float64, len(tied))
+	for i := range tied {
+		for j := range tied {
+			a, b := idx[tied[i].UUID], idx[tied[j].UUID]
+			if i != j && p[a][b] > p[b][a] {
+				ret[i]++
+			}
+		}
+	}
+	return ret
+}
+
+// firstChoiceTieBreak scores each tied team by the number of votes that
+// ranked it first
+func firstChoiceTieBreak(ctx *tieBreakContext, tied []Team) []float64 {
+	firsts := make(map[string]float64)
+	for _, v := range ctx.votes {
+		if order := ballotOrder(v); len(order) > 0 {
+			firsts[order[0]]++
+		}
+	}
+	ret := make([]float64, len(tied))
+	for i := range tied {
+		ret[i] = firsts[tied[i].UUID]
+	}
+	return ret
+}
+
+// coinFlipTieBreak orders the tied teams by the coin flips that an admin
+// recorded, teams earlier in the list win. If any of the tied teams haven't
+// had a coin flip recorded, they all stay tied.
+func coinFlipTieBreak(ctx *tieBreakContext, tied []Team) []float64 {
+	ret := make([]float64, len(tied))
+	for i := range tied {
+		pos := -1
+		for k, v := range ctx.coinFlips {
+			if v == tied[i].UUID {
+				pos = k
+				break
+			}
+		}
+		if pos == -1 {
+			return make([]float64, len(tied))
+		}
+		ret[i] = float64(-pos)
+	}
+	return ret
+}
+
+// RecordCoinFlip records the order that a coin flip put the given teams in
+// Any earlier coin flip result for these teams is replaced
+func (gj *Gamejam) RecordCoinFlip(order []string) error {
+	for _, o := range order {
+		if _, err := gj.GetTeamById(o); err != nil {
+			return err
+		}
+	}
+	gj.CoinFlips = mergeCoinFlip(gj.CoinFlips, order)
+	gj.IsChanged = true
+	return nil
+}
+
+// mergeCoinFlip returns flips with the teams in order moved to the end, in order
+func mergeCoinFlip(flips, order []string) []string {
+	var ret []string
+	for _, v := range flips {
+		found := false
+		for _, o := range order {
+			if v == o {
+				found = true
+				break
+			}
+		}
+		if !found {
+			ret = append(ret, v)
+		}
+	}
+	return append(ret, order...)
+}
+
+// GetTieBreakNames returns the display names of the jam's tie-break chain
+func (gj *Gamejam) GetTieBreakNames() []string {
+	var ret []string
+	for _, k := range gj.TieBreaks {
+		ret = append(ret, getTieBreakerName(k))
+	}
+	return ret
+}
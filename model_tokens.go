@@ -0,0 +1,215 @@
+package main
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pborman/uuid"
+)
+
+// These are all model functions that have to do with API tokens
+// Like users, we manipulate the DB directly so that a revoked token
+// stops working right away.
+
+/**
+ * APIToken
+ * A named token that scripts and integrations send as a Bearer token
+ * Only a hash of the token is stored, the token itself is only shown
+ * once, when it's created.
+ */
+type APIToken struct {
+	UUID     string
+	Name     string
+	Scopes   []string
+	Created  time.Time
+	LastUsed time.Time
+
+	hash  string
+	mPath []string // The path in the DB to this token
+}
+
+// How often a token's last used time is written to the DB
+const tokenLastUsedGranularity = time.Minute
+
+// Token scopes: What a token is allowed to do
+const (
+	ScopeResults = "results" // Read-only access to results
+	ScopeTeams   = "teams"   // Manage teams, members and games
+	ScopeAdmin   = "admin"   // Everything an admin can do
+)
+
+// tokenScopes is the order that scopes are offered to admins
+var tokenScopes = []string{ScopeResults, ScopeTeams, ScopeAdmin}
+
+func NewAPIToken(id string) *APIToken {
+	if id == "" {
+		id = uuid.New()
+	}
+	return &APIToken{
+		UUID:  id,
+		mPath: []string{"tokens", id},
+	}
+}
+
+// HasScope returns whether the token grants scope
+// Admin tokens have every scope
+func (t *APIToken) HasScope(scope string) bool {
+	for _, v := range t.Scopes {
+		if v == scope || v == ScopeAdmin {
+			return true
+		}
+	}
+	return false
+}
+
+func isValidTokenScope(scope string) bool {
+	for _, v := range tokenScopes {
+		if v == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// hashToken returns the hex encoded sha256 of a token
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func (m *model) getAllTokens() []APIToken {
+	var ret []APIToken
+	if err := m.openDB(); err != nil {
+		return ret
+	}
+	defer m.closeDB()
+
+	ids, err := m.db.GetBucketList([]string{"tokens"})
+	if err != nil {
+		return ret
+	}
+	for _, v := range ids {
+		if tkn, err := m.loadToken(v); err == nil {
+			ret = append(ret, *tkn)
+		}
+	}
+	return ret
+}
+
+func (m *model) loadToken(id string) (*APIToken, error) {
+	var err error
+	if err = m.openDB(); err != nil {
+		return nil, err
+	}
+	defer m.closeDB()
+
+	tkn := NewAPIToken(id)
+	if tkn.hash, err = m.db.GetValue(tkn.mPath, "hash"); err != nil || tkn.hash == "" {
+		return nil, errors.New("Invalid Token")
+	}
+	tkn.Name, _ = m.db.GetValue(tkn.mPath, "name")
+	if scopes, _ := m.db.GetValue(tkn.mPath, "scopes"); scopes != "" {
+		tkn.Scopes = strings.Split(scopes, ",")
+	}
+	if ts, err := m.db.GetValue(tkn.mPath, "created"); err == nil {
+		tkn.Created, _ = time.Parse(time.RFC3339, ts)
+	}
+	if ts, err := m.db.GetValue(tkn.mPath, "lastused"); err == nil {
+		tkn.LastUsed, _ = time.Parse(time.RFC3339, ts)
+	}
+	return tkn, nil
+}
+
+// createToken makes a new token with the given name and scopes
+// It returns the token itself, which can't be recovered later
+func (m *model) createToken(name string, scopes []string) (string, *APIToken, error) {
+	var err error
+	if strings.TrimSpace(name) == "" {
+		return "", nil, errors.New("A token name is required")
+	}
+	tkn := NewAPIToken("")
+	tkn.Name = strings.TrimSpace(name)
+	for _, v := range scopes {
+		if isValidTokenScope(v) && !tkn.HasScope(v) {
+			tkn.Scopes = append(tkn.Scopes, v)
+		}
+	}
+	if len(tkn.Scopes) == 0 {
+		return "", nil, errors.New("At least one scope is required")
+	}
+	tkn.Created = time.Now()
+	b := make([]byte, 32)
+	if _, err = rand.Read(b); err != nil {
+		return "", nil, err
+	}
+	secret := hex.EncodeToString(b)
+	tkn.hash = hashToken(secret)
+
+	if err = m.openDB(); err != nil {
+		return "", nil, err
+	}
+	defer m.closeDB()
+	if err = m.db.SetValue(tkn.mPath, "name", tkn.Name); err != nil {
+		return "", nil, err
+	}
+	if err = m.db.SetValue(tkn.mPath, "scopes", strings.Join(tkn.Scopes, ",")); err != nil {
+		return "", nil, err
+	}
+	if err = m.db.SetValue(tkn.mPath, "created", tkn.Created.Format(time.RFC3339)); err != nil {
+		return "", nil, err
+	}
+	if err = m.db.SetValue(tkn.mPath, "hash", tkn.hash); err != nil {
+		return "", nil, err
+	}
+	return secret, tkn, nil
+}
+
+// checkToken finds the token matching secret and records that it was used
+func (m *model) checkToken(secret string) (*APIToken, error) {
+	if secret == "" {
+		return nil, errors.New("Invalid Token")
+	}
+	hash := hashToken(secret)
+	for _, tkn := range m.getAllTokens() {
+		if subtle.ConstantTimeCompare([]byte(tkn.hash), []byte(hash)) == 1 {
+			// Last used doesn't need to be exact, so a token that's being
+			// used a lot isn't written to the DB on every request
+			now := time.Now()
+			if now.Sub(tkn.LastUsed) < tokenLastUsedGranularity {
+				return &tkn, nil
+			}
+			tkn.LastUsed = now
+			if err := m.setTokenLastUsed(&tkn); err != nil {
+				fmt.Println("Error saving token last used time: " + err.Error())
+			}
+			return &tkn, nil
+		}
+	}
+	return nil, errors.New("Invalid Token")
+}
+
+func (m *model) setTokenLastUsed(tkn *APIToken) error {
+	var err error
+	if err = m.openDB(); err != nil {
+		return err
+	}
+	defer m.closeDB()
+
+	return m.db.SetValue(tkn.mPath, "lastused", tkn.LastUsed.Format(time.RFC3339))
+}
+
+func (m *model) deleteToken(id string) error {
+	var err error
+	if err = m.openDB(); err != nil {
+		return err
+	}
+	defer m.closeDB()
+
+	return m.db.DeleteBucket([]string{"tokens"}, id)
+}
@@ -18,7 +18,7 @@ func (m *model) getAllUsers() []string {
 	}
 	defer m.closeDB()
 
-	usrs, err := m.bolt.GetBucketList([]string{"users"})
+	usrs, err := m.db.GetBucketList([]string{"users"})
 	if err != nil {
 		return []string{}
 	}
@@ -33,7 +33,7 @@ func (m *model) isValidUserEmail(email string) bool {
 	defer m.closeDB()
 
 	usrPath := []string{"users", email}
-	_, err := m.bolt.GetValue(usrPath, "password")
+	_, err := m.db.GetValue(usrPath, "password")
 	return err == nil
 }
 
@@ -47,7 +47,7 @@ func (m *model) checkCredentials(email, pw string) error {
 
 	var uPw string
 	usrPath := []string{"users", email}
-	if uPw, err = m.bolt.GetValue(usrPath, "password"); err != nil {
+	if uPw, err = m.db.GetValue(usrPath, "password"); err != nil {
 		return err
 	}
 	return bcrypt.CompareHashAndPassword([]byte(uPw), []byte(pw))
@@ -68,7 +68,7 @@ func (m *model) updateUserPassword(email, password string) error {
 	defer m.closeDB()
 
 	usrPath := []string{"users", email}
-	return m.bolt.SetValue(usrPath, "password", string(cryptPw))
+	return m.db.SetValue(usrPath, "password", string(cryptPw))
 }
 
 func (m *model) deleteUser(email string) error {
@@ -78,5 +78,5 @@ func (m *model) deleteUser(email string) error {
 	}
 	defer m.closeDB()
 
-	return m.bolt.DeleteBucket([]string{"users"}, email)
+	return m.db.DeleteBucket([]string{"users"}, email)
 }
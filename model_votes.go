@@ -3,7 +3,9 @@ package main
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -15,11 +17,13 @@ type GameChoice struct {
 
 // A Vote is a collection of game rankings
 type Vote struct {
-	Timestamp   time.Time
-	ClientId    string // UUID of client
-	Choices     []GameChoice
-	VoterStatus string
-	Discovery   string
+	Timestamp time.Time
+	ClientId  string // UUID of client
+	Choices   []GameChoice
+	// Ranked ballots for award categories, keyed by category UUID
+	CategoryChoices map[string][]GameChoice
+	VoterStatus     string
+	Discovery       string
 
 	mPath []string // The path in the DB to this team
 }
@@ -35,6 +39,7 @@ func NewVote(clId string, tm time.Time) (*Vote, error) {
 	vt := new(Vote)
 
 	vt.Timestamp = tm
+	vt.ClientId = clId
 	vt.mPath = []string{"jam", "votes", clId, tm.Format(time.RFC3339)}
 
 	return vt, nil
@@ -43,8 +48,96 @@ func NewVote(clId string, tm time.Time) (*Vote, error) {
 func (vt *Vote) SetChoices(ch []string) error {
 	// Clear any previous choices from this vote
 	vt.Choices = []GameChoice{}
-	for i, v := range ch {
-		vt.Choices = append(vt.Choices, GameChoice{Rank: i, Team: v})
+	for _, v := range ch {
+		// The voting page sends a trailing comma, skip empty choices
+		if strings.TrimSpace(v) == "" {
+			continue
+		}
+		vt.Choices = append(vt.Choices, GameChoice{Rank: len(vt.Choices), Team: v})
+	}
+	return nil
+}
+
+// SetCategoryChoices sets the ranked ballot for an award category
+func (vt *Vote) SetCategoryChoices(catId string, ch []string) error {
+	if vt.CategoryChoices == nil {
+		vt.CategoryChoices = make(map[string][]GameChoice)
+	}
+	var chcs []GameChoice
+	for _, v := range ch {
+		if strings.TrimSpace(v) == "" {
+			continue
+		}
+		chcs = append(chcs, GameChoice{Rank: len(chcs), Team: v})
+	}
+	if len(chcs) == 0 {
+		delete(vt.CategoryChoices, catId)
+		return nil
+	}
+	vt.CategoryChoices[catId] = chcs
+	return nil
+}
+
+// sortChoices puts the vote's choices in rank order
+// Choices are stored keyed by rank, which the DB returns sorted as strings ("10" before "2")
+func (vt *Vote) sortChoices() {
+	sortGameChoices(vt.Choices)
+	for _, v := range vt.CategoryChoices {
+		sortGameChoices(v)
+	}
+}
+
+func sortGameChoices(chcs []GameChoice) {
+	sort.SliceStable(chcs, func(i, j int) bool {
+		return chcs[i].Rank < chcs[j].Rank
+	})
+}
+
+// loadChoices reads the ranked choices stored in the given bucket
+func loadChoices(bolt Store, path []string) ([]GameChoice, error) {
+	var ret []GameChoice
+	keys, err := bolt.GetKeyList(path)
+	if err != nil {
+		return ret, err
+	}
+	for _, v := range keys {
+		ch := new(GameChoice)
+		var rank int
+		if rank, err = strconv.Atoi(v); err == nil {
+			ch.Rank = rank
+			if ch.Team, err = bolt.GetValue(path, v); err == nil {
+				ret = append(ret, *ch)
+			}
+		}
+	}
+	return ret, nil
+}
+
+// loadCategoryChoices reads the category ballots stored under a vote's bucket
+func loadCategoryChoices(bolt Store, path []string) map[string][]GameChoice {
+	ret := make(map[string][]GameChoice)
+	catPath := append(append([]string{}, path...), "categories")
+	catIds, err := bolt.GetBucketList(catPath)
+	if err != nil {
+		return ret
+	}
+	for _, catId := range catIds {
+		if chcs, err := loadChoices(bolt, append(append([]string{}, catPath...), catId)); err == nil && len(chcs) > 0 {
+			ret[catId] = chcs
+		}
+	}
+	return ret
+}
+
+// saveCategoryChoices writes a vote's category ballots under its bucket
+func saveCategoryChoices(bolt Store, path []string, catChoices map[string][]GameChoice) error {
+	for catId, chcs := range catChoices {
+		catPath := append(append([]string{}, path...), "categories", catId)
+		for _, v := range chcs {
+			if err := bolt.SetValue(catPath, strconv.Itoa(v.Rank), v.Team); err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
@@ -71,13 +164,18 @@ func (gj *Gamejam) AddVote(vt *Vote) error {
 	if _, err := gj.GetVote(vt.ClientId, vt.Timestamp); err == nil {
 		return errors.New("Duplicate Vote")
 	}
+	// The vote isn't accepted until it's in the DB
+	if err := gj.SaveVote(vt); err != nil {
+		return errors.New("Error saving vote: " + err.Error())
+	}
 	gj.Votes = append(gj.Votes, *vt)
+	gj.m.publishEvent(EventVote)
 	return nil
 }
 
 /**
  * DB Functions
- * These are generally just called when the app starts up or when the periodic 'save' runs
+ * Votes are loaded when the app starts up, and each one is saved when it's cast
  */
 
 // LoadAllVotes loads all votes for the jam out of the database
@@ -91,13 +189,13 @@ func (gj *Gamejam) LoadAllVotes() []Vote {
 
 	votesPath := []string{"jam", "votes"}
 	var cliUUIDs []string
-	if cliUUIDs, err = gj.m.bolt.GetBucketList(votesPath); err != nil {
+	if cliUUIDs, err = gj.m.db.GetBucketList(votesPath); err != nil {
 		return ret
 	}
 	for _, cId := range cliUUIDs {
 		vtsPth := append(votesPath, cId)
 		var times []string
-		if times, err = gj.m.bolt.GetBucketList(vtsPth); err != nil {
+		if times, err = gj.m.db.GetBucketList(vtsPth); err != nil {
 			// Error reading this bucket, move on to the next
 			continue
 		}
@@ -122,29 +220,22 @@ func (gj *Gamejam) LoadVote(clientId, t string) (*Vote, error) {
 	if err != nil {
 		return nil, errors.New("Error creating vote: " + err.Error())
 	}
-	var choices []string
-	if choices, err = gj.m.bolt.GetKeyList(vt.mPath); err != nil {
+	if vt.Choices, err = loadChoices(gj.m.db, vt.mPath); err != nil {
 		return nil, errors.New("Error creating vote: " + err.Error())
 	}
-	for _, v := range choices {
-		ch := new(GameChoice)
-		var rank int
-		if rank, err = strconv.Atoi(v); err == nil {
-			ch.Rank = rank
-			if ch.Team, err = gj.m.bolt.GetValue(vt.mPath, v); err == nil {
-				vt.Choices = append(vt.Choices, *ch)
-			}
-		}
-	}
-	if vt.VoterStatus, err = gj.m.bolt.GetValue(vt.mPath, "voterstatus"); err != nil {
+	vt.CategoryChoices = loadCategoryChoices(gj.m.db, vt.mPath)
+	vt.sortChoices()
+	if vt.VoterStatus, err = gj.m.db.GetValue(vt.mPath, "voterstatus"); err != nil {
 		vt.VoterStatus = ""
 	}
-	if vt.Discovery, err = gj.m.bolt.GetValue(vt.mPath, "discovery"); err != nil {
+	if vt.Discovery, err = gj.m.db.GetValue(vt.mPath, "discovery"); err != nil {
 		vt.Discovery = ""
 	}
 	return vt, nil
 }
 
+// SaveVote writes the whole vote in one transaction, so a failed write
+// can't leave half of it in the DB
 func (gj *Gamejam) SaveVote(vt *Vote) error {
 	var err error
 	if err = gj.m.openDB(); err != nil {
@@ -152,10 +243,18 @@ func (gj *Gamejam) SaveVote(vt *Vote) error {
 	}
 	defer gj.m.closeDB()
 
-	for _, v := range vt.Choices {
-		m.bolt.SetValue(vt.mPath, strconv.Itoa(v.Rank), v.Team)
-	}
-	m.bolt.SetValue(vt.mPath, "voterstatus", vt.VoterStatus)
-	m.bolt.SetValue(vt.mPath, "discovery", vt.Discovery)
-	return nil
+	return gj.m.db.Update(func(tx Store) error {
+		for _, v := range vt.Choices {
+			if err := tx.SetValue(vt.mPath, strconv.Itoa(v.Rank), v.Team); err != nil {
+				return err
+			}
+		}
+		if err := tx.SetValue(vt.mPath, "voterstatus", vt.VoterStatus); err != nil {
+			return err
+		}
+		if err := tx.SetValue(vt.mPath, "discovery", vt.Discovery); err != nil {
+			return err
+		}
+		return saveCategoryChoices(tx, vt.mPath, vt.CategoryChoices)
+	})
 }
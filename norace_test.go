@@ -0,0 +1,7 @@
+//go:build !race
+// +build !race
+
+package main
+
+// raceEnabled is whether the tests were built with -race
+const raceEnabled = false
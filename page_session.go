@@ -2,9 +2,9 @@ package main
 
 import (
 	"fmt"
-	"net"
 	"net/http"
 
+	"github.com/gorilla/securecookie"
 	"github.com/gorilla/sessions"
 	"github.com/pborman/uuid"
 )
@@ -32,34 +32,64 @@ func (p *pageSession) setStringValue(key, val string) {
 	p.session.Save(p.req, p.w)
 }
 
+/**
+ * Device Cookie
+ * A client's ID is kept in its own long-lived cookie, apart from the session,
+ * so that logging out on a kiosk doesn't make it a new client. The cookie is
+ * signed with the session secret, so a client can't take another's ID.
+ */
+
+// How long a device cookie lasts, in seconds
+const deviceCookieMaxAge = 10 * 365 * 24 * 60 * 60
+
+// deviceCookie signs and checks device cookies, it's set up with the session
+// store
+var deviceCookie *securecookie.SecureCookie
+
+func newDeviceCookie(secret string) *securecookie.SecureCookie {
+	return securecookie.New([]byte(secret), nil).MaxAge(deviceCookieMaxAge)
+}
+
+func deviceCookieName() string {
+	return m.site.SessionName + "-device"
+}
+
 func (p *pageSession) getClientId() string {
 	var clientId string
-	var err error
-	if clientId, err = p.getStringValue("client_id"); err != nil {
-		// No client id in session, check if we have one for this ip in the db
-		fmt.Println("Looking for Client Data")
-		clientIp, _, _ := net.SplitHostPort(p.req.RemoteAddr)
-		var cli *Client
-		fmt.Println("  Client IP:" + clientIp)
-		if clientIp != "127.0.0.1" {
-			fmt.Println("  Pulling data by IP")
-			if cli, err = m.GetClientByIp(clientIp); err != nil {
-				cli = NewClient(clientId)
-			}
+	if c, err := p.req.Cookie(deviceCookieName()); err == nil {
+		if err = deviceCookie.Decode(deviceCookieName(), c.Value, &clientId); err != nil {
+			fmt.Println("Invalid device cookie: " + err.Error())
+			clientId = ""
 		}
-		if cli != nil {
-			clientId = cli.UUID
-			p.setStringValue("client_id", clientId)
-		} else {
-			// No client id, generate and save one
-			fmt.Println("  Generating a new Client ID")
+	}
+	if clientId == "" {
+		// Clients from before the device cookie kept their ID in the session
+		if clientId, _ = p.getStringValue("client_id"); clientId == "" {
 			clientId = uuid.New()
-			p.setStringValue("client_id", clientId)
 		}
+		p.setDeviceCookie(clientId)
 	}
 	return clientId
 }
 
+// setDeviceCookie gives the client the device cookie for clientId
+func (p *pageSession) setDeviceCookie(clientId string) {
+	val, err := deviceCookie.Encode(deviceCookieName(), clientId)
+	if err != nil {
+		fmt.Println("Error making device cookie: " + err.Error())
+		return
+	}
+	http.SetCookie(p.w, &http.Cookie{
+		Name:     deviceCookieName(),
+		Value:    val,
+		Path:     "/",
+		MaxAge:   deviceCookieMaxAge,
+		HttpOnly: true,
+		Secure:   p.req.TLS != nil,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
 func (p *pageSession) setFlashMessage(msg, status string) {
 	p.setStringValue("flash_message", msg)
 	p.setStringValue("flash_status", status)
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+)
+
+/**
+ * Public Archive
+ * Anyone can browse the archived jams: their results, their games and the
+ * team members that asked to be listed.
+ */
+
+func handlePublicArchive(w http.ResponseWriter, req *http.Request) {
+	vars := mux.Vars(req)
+	if vars["id"] == "" {
+		handlePastJamsPage(w, req)
+		return
+	}
+	page := initPublicPage(w, req)
+	gj, err := m.archive.GetArchivedJam(vars["id"])
+	if err != nil {
+		page.session.setFlashMessage("Couldn't find that jam", "error")
+		redirect("/jams", w, req)
+		return
+	}
+	if vars["teamid"] != "" {
+		handlePublicArchivedGame(w, req, page, gj, vars["teamid"])
+		return
+	}
+	type categoryStandings struct {
+		Name      string
+		Standings []ArchivedStanding
+	}
+	type jamPageData struct {
+		Jam        *ArchivedGamejam
+		Standings  []ArchivedStanding
+		Categories []categoryStandings
+	}
+	jpd := new(jamPageData)
+	jpd.Jam = gj
+	jpd.Standings = gj.standings(gj.Rankings, gj.RankPlaces, gj.RankTieBreaks)
+	for _, cat := range gj.Categories {
+		cs := categoryStandings{
+			Name:      cat.Name,
+			Standings: gj.standings(cat.Rankings, cat.RankPlaces, cat.RankTieBreaks),
+		}
+		if len(cs.Standings) > 0 {
+			jpd.Categories = append(jpd.Categories, cs)
+		}
+	}
+	page.SubTitle = gj.Name
+	page.TemplateData = jpd
+	page.show("public-jam.html", w)
+}
+
+// handlePublicArchivedGame shows one game from an archived jam
+func handlePublicArchivedGame(w http.ResponseWriter, req *http.Request, page *pageData, gj *ArchivedGamejam, tmId string) {
+	tm, err := gj.GetTeamById(tmId)
+	if err != nil || tm.Game == nil {
+		page.session.setFlashMessage("Couldn't find that game", "error")
+		redirect("/jams/"+gj.UUID, w, req)
+		return
+	}
+	type gamePageData struct {
+		Jam     *ArchivedGamejam
+		Team    *Team
+		Members []TeamMember // Only the members that asked to be listed
+		Place   int          // 0 if the game wasn't ranked
+	}
+	gpd := new(gamePageData)
+	gpd.Jam = gj
+	gpd.Team = tm
+	for _, mbr := range tm.Members {
+		if mbr.Public {
+			gpd.Members = append(gpd.Members, mbr)
+		}
+	}
+	for _, st := range gj.standings(gj.Rankings, gj.RankPlaces, gj.RankTieBreaks) {
+		if st.Team.UUID == tm.UUID {
+			gpd.Place = st.Place
+			break
+		}
+	}
+	page.SubTitle = tm.Game.Name
+	page.TemplateData = gpd
+	page.show("public-jamgame.html", w)
+}
+
+func handleArchivedImageRequest(w http.ResponseWriter, req *http.Request) {
+	serveArchivedScreenshot(w, req, false)
+}
+
+func handleArchivedThumbnailRequest(w http.ResponseWriter, req *http.Request) {
+	serveArchivedScreenshot(w, req, true)
+}
+
+// serveArchivedScreenshot streams a screenshot from an archived jam
+func serveArchivedScreenshot(w http.ResponseWriter, req *http.Request, thumbnail bool) {
+	vars := mux.Vars(req)
+	m.mu.Lock()
+	var hash string
+	gj, err := m.archive.GetArchivedJam(vars["id"])
+	if err == nil {
+		var tm *Team
+		if tm, err = gj.GetTeamById(vars["teamid"]); err == nil {
+			hash, err = screenshotHash(tm, vars["imageid"], thumbnail, req)
+		}
+	}
+	m.mu.Unlock()
+	if err != nil {
+		fmt.Println("serveArchivedScreenshot: " + err.Error())
+		http.Error(w, "Couldn't find image", 404)
+		return
+	}
+	serveImageFile(w, req, hash)
+}
@@ -2,13 +2,17 @@ package main
 
 import (
 	"encoding/base64"
+	"errors"
 	"fmt"
+	"html/template"
 	"math/rand"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/gorilla/mux"
+	qrcode "github.com/skip2/go-qrcode"
 )
 
 func initPublicPage(w http.ResponseWriter, req *http.Request) *pageData {
@@ -37,7 +41,11 @@ func handleMain(w http.ResponseWriter, req *http.Request) {
 		vars := mux.Vars(req)
 		switch vars["function"] {
 		case "":
-			loadVotingPage(w, req)
+			if m.site.GetPublicMode() == SiteModeResults {
+				loadResultsPage(w, req)
+			} else {
+				loadVotingPage(w, req)
+			}
 		case "vote":
 			handlePublicSaveVote(w, req)
 		}
@@ -58,10 +66,10 @@ func handlePastJamsPage(w http.ResponseWriter, req *http.Request) {
 	for _, v := range m.archive.Jams {
 		a := new(archivePageData)
 		a.Jam = v
-		for _, t := range v.Teams {
-			if t.UUID == v.Rankings[0] {
-				a.Winner = t
-				break
+		// A jam that didn't get any votes doesn't have a winner
+		if len(v.Rankings) > 0 {
+			if tm, err := v.GetTeamById(v.Rankings[0]); err == nil {
+				a.Winner = *tm
 			}
 		}
 		p.Archive = append(p.Archive, *a)
@@ -82,14 +90,23 @@ func loadVotingPage(w http.ResponseWriter, req *http.Request) {
 	page := initPublicPage(w, req)
 	// Client authentication required
 	if (m.site.GetAuthMode() == AuthModeAuthentication) && !page.ClientIsAuth {
-		page.show("unauthorized.html", w)
+		showUnauthorized(page, w, req)
 		return
 	}
 	type votingPageData struct {
-		Teams     []Team
-		Timestamp string
+		Teams         []Team
+		Categories    []Category
+		CategoryRanks []string
+		Timestamp     string
 	}
 	vpd := new(votingPageData)
+	vpd.Categories = m.jam.Categories
+	// Category ballots rank up to three games
+	for _, v := range []string{"1st", "2nd", "3rd"} {
+		if len(vpd.CategoryRanks) < len(m.jam.Teams) {
+			vpd.CategoryRanks = append(vpd.CategoryRanks, v)
+		}
+	}
 	tms := make([]Team, len(m.jam.Teams))
 	copy(tms, m.jam.Teams)
 
@@ -106,11 +123,44 @@ func loadVotingPage(w http.ResponseWriter, req *http.Request) {
 	page.show("public-voting.html", w)
 }
 
+// loadResultsPage shows the final standings once voting has closed
+func loadResultsPage(w http.ResponseWriter, req *http.Request) {
+	page := initPublicPage(w, req)
+	page.SubTitle = "Results"
+	type categoryResults struct {
+		Name    string
+		Results []Ranking
+	}
+	type resultsPageData struct {
+		JamName    string
+		Results    []Ranking
+		Categories []categoryResults
+	}
+	rpd := new(resultsPageData)
+	rpd.JamName = m.jam.Name
+	rpd.Results = m.jam.GetResults()
+	for i := range m.jam.Categories {
+		rpd.Categories = append(rpd.Categories, categoryResults{
+			Name:    m.jam.Categories[i].Name,
+			Results: m.jam.GetCategoryResults(&m.jam.Categories[i]),
+		})
+	}
+	page.TemplateData = rpd
+	page.show("public-results.html", w)
+}
+
 func handlePublicSaveVote(w http.ResponseWriter, req *http.Request) {
 	page := initPublicPage(w, req)
 	// Client authentication required
 	if (m.site.GetAuthMode() == AuthModeAuthentication) && !page.ClientIsAuth {
-		page.show("unauthorized.html", w)
+		showUnauthorized(page, w, req)
+		return
+	}
+	// Ballots that come in after voting closes don't count, even if the
+	// voting page was loaded while it was still open
+	if m.site.GetPublicMode() != SiteModeVoting || m.jam.VotingIsClosed(time.Now()) {
+		page.session.setFlashMessage("Sorry, voting has closed", "error")
+		redirect("/", w, req)
 		return
 	}
 
@@ -123,6 +173,7 @@ func handlePublicSaveVote(w http.ResponseWriter, req *http.Request) {
 		page.session.setFlashMessage("Error creating vote", "error")
 		fmt.Println("Error parsing timestamp: " + ts)
 		redirect("/", w, req)
+		return
 	}
 	client, err := m.GetClient(page.ClientId)
 	if err != nil {
@@ -142,6 +193,7 @@ func handlePublicSaveVote(w http.ResponseWriter, req *http.Request) {
 		// Duplicate vote... Cancel it.
 		page.session.setFlashMessage("Duplicate vote!", "error")
 		redirect("/", w, req)
+		return
 	}
 
 	var vt *Vote
@@ -149,11 +201,25 @@ func handlePublicSaveVote(w http.ResponseWriter, req *http.Request) {
 		fmt.Println("Error creating vote: " + err.Error())
 		page.session.setFlashMessage("Error creating vote", "error")
 		redirect("/", w, req)
+		return
 	}
 	if err = vt.SetChoices(voteSlice); err != nil {
 		fmt.Println("Error creating vote: " + err.Error())
 		page.session.setFlashMessage("Error creating vote", "error")
 		redirect("/", w, req)
+		return
+	}
+	// Each award category has its own ranked ballot
+	for _, cat := range m.jam.Categories {
+		var catSlice []string
+		seen := make(map[string]bool)
+		for _, v := range req.Form["catvote-"+cat.UUID] {
+			if _, err := m.jam.GetTeamById(v); err == nil && !seen[v] {
+				seen[v] = true
+				catSlice = append(catSlice, v)
+			}
+		}
+		vt.SetCategoryChoices(cat.UUID, catSlice)
 	}
 	vt.VoterStatus = voterStatus
 	vt.Discovery = discovery
@@ -162,60 +228,112 @@ func handlePublicSaveVote(w http.ResponseWriter, req *http.Request) {
 		fmt.Println("Error adding vote: " + err.Error())
 		page.session.setFlashMessage("Error creating vote", "error")
 		redirect("/", w, req)
+		return
 	}
 	//page.session.setFlashMessage("Vote Saved!", "success large fading")
 	//redirect("/", w, req)
 	page.show("public-votedone.html", w)
 }
 
+// showUnauthorized shows an unauthenticated client the code that an admin
+// can pair it with
+func showUnauthorized(page *pageData, w http.ResponseWriter, req *http.Request) {
+	type unauthorizedPageData struct {
+		Code    string
+		PairURL string
+		QRCode  template.URL // A data: URL of a PNG of the QR code for PairURL
+	}
+	upd := new(unauthorizedPageData)
+	if pc, err := m.PairingCodeFor(page.ClientId, requestIp(req)); err != nil {
+		fmt.Println("Error making pairing code: " + err.Error())
+	} else {
+		upd.Code = pc.Display()
+		// The QR code is scanned by a phone, so it needs the address that the
+		// client reached the site on
+		scheme := "http"
+		if req.TLS != nil {
+			scheme = "https"
+		}
+		upd.PairURL = scheme + "://" + req.Host + "/admin/clients/pair?code=" + pc.Code
+		if png, err := qrcode.Encode(upd.PairURL, qrcode.Medium, 256); err != nil {
+			fmt.Println("Error making pairing QR code: " + err.Error())
+		} else {
+			upd.QRCode = template.URL("data:image/png;base64," + base64.StdEncoding.EncodeToString(png))
+		}
+	}
+	page.TemplateData = upd
+	page.show("unauthorized.html", w)
+}
+
 func handleThumbnailRequest(w http.ResponseWriter, req *http.Request) {
 	// Thumbnail requests are open even without client authentication
+	serveScreenshot(w, req, true)
+}
+
+func handleImageRequest(w http.ResponseWriter, req *http.Request) {
+	// Image requests are open even without client authentication
+	serveScreenshot(w, req, false)
+}
+
+// serveScreenshot streams a screenshot (or its thumbnail) from the screenshot store
+// These are routed on their own, so the model is only locked while the file
+// is looked up, not while it's sent
+func serveScreenshot(w http.ResponseWriter, req *http.Request, thumbnail bool) {
 	vars := mux.Vars(req)
+	m.mu.Lock()
+	var hash string
 	tm, err := m.jam.GetTeamById(vars["teamid"])
-	if err != nil {
-		fmt.Println("handleThumbnailRequest: " + err.Error())
-		http.Error(w, "Couldn't find image", 404)
-		return
-	}
-	ss, err := tm.Game.GetScreenshot(vars["imageid"])
-	if err != nil {
-		fmt.Println("handleThumbnailRequest: " + err.Error())
-		http.Error(w, "Couldn't find image", 404)
-		return
+	if err == nil {
+		hash, err = screenshotHash(tm, vars["imageid"], thumbnail, req)
 	}
-	w.Header().Set("Content-Type", "image/"+ss.Filetype)
-	dat, err := base64.StdEncoding.DecodeString(ss.Thumbnail)
+	m.mu.Unlock()
 	if err != nil {
-		fmt.Println("handleThumbnailRequest: " + err.Error())
+		fmt.Println("serveScreenshot: " + err.Error())
 		http.Error(w, "Couldn't find image", 404)
 		return
 	}
-	w.Write(dat)
+	serveImageFile(w, req, hash)
 }
 
-func handleImageRequest(w http.ResponseWriter, req *http.Request) {
-	// Image requests are open even without client authentication
-	vars := mux.Vars(req)
-	tm, err := m.jam.GetTeamById(vars["teamid"])
+// screenshotHash returns the hash of the file to serve for one of tm's screenshots
+func screenshotHash(tm *Team, ssId string, thumbnail bool, req *http.Request) (string, error) {
+	if tm.Game == nil {
+		return "", errors.New("Team has no game")
+	}
+	ss, err := tm.Game.GetScreenshot(ssId)
 	if err != nil {
-		fmt.Println("handleImageRequest: " + err.Error())
-		http.Error(w, "Couldn't find image", 404)
-		return
+		return "", err
+	}
+	if thumbnail {
+		// ?w= asks for a thumbnail at least that wide
+		wd, _ := strconv.Atoi(req.FormValue("w"))
+		return ss.ThumbnailFor(wd), nil
 	}
-	ss, err := tm.Game.GetScreenshot(vars["imageid"])
+	return ss.ImageHash, nil
+}
+
+// serveImageFile sends the file with the given hash from the screenshot store
+// http.ServeContent takes care of Range requests, and since a file's
+// contents never change, its hash makes a good ETag
+func serveImageFile(w http.ResponseWriter, req *http.Request, hash string) {
+	f, err := openImage(hash)
 	if err != nil {
-		fmt.Println("handleImageRequest: " + err.Error())
+		fmt.Println("serveImageFile: " + err.Error())
 		http.Error(w, "Couldn't find image", 404)
 		return
 	}
-	w.Header().Set("Content-Type", "image/"+ss.Filetype)
-	dat, err := base64.StdEncoding.DecodeString(ss.Image)
+	defer f.Close()
+	fi, err := f.Stat()
 	if err != nil {
-		fmt.Println("handleImageRequest: " + err.Error())
+		fmt.Println("serveImageFile: " + err.Error())
 		http.Error(w, "Couldn't find image", 404)
 		return
 	}
-	w.Write(dat)
+	w.Header().Set("ETag", `"`+hash+`"`)
+	w.Header().Set("Cache-Control", "public, max-age=86400")
+	// The content type is sniffed from the file, older uploads didn't always
+	// match their Filetype
+	http.ServeContent(w, req, "", fi.ModTime(), f)
 }
 
 func handleTeamMgmtRequest(w http.ResponseWriter, req *http.Request) {
@@ -237,25 +355,43 @@ func handleTeamMgmtRequest(w http.ResponseWriter, req *http.Request) {
 			page.show("public-teammgmt.html", w)
 
 		case "savemember":
-			m, err := NewTeamMember(tm.UUID, "")
+			mbr, err := NewTeamMember(tm.UUID, "")
 			if err != nil {
 				page.session.setFlashMessage("Error adding team member: "+err.Error(), "error")
 				redirect("/team/"+tm.UUID+"#members", w, req)
 			}
-			m.Name = req.FormValue("newmembername")
-			m.SlackId = req.FormValue("newmemberslackid")
-			m.Twitter = req.FormValue("newmembertwitter")
-			m.Email = req.FormValue("newmemberemail")
-			if err := tm.AddTeamMember(m); err != nil {
+			mbr.Name = req.FormValue("newmembername")
+			mbr.SlackId = req.FormValue("newmemberslackid")
+			mbr.Twitter = req.FormValue("newmembertwitter")
+			mbr.Email = req.FormValue("newmemberemail")
+			mbr.Public = req.FormValue("newmemberpublic") == "true"
+			if err := tm.AddTeamMember(mbr); err != nil {
+				page.session.setFlashMessage("Error adding team member: "+err.Error(), "error")
+			} else if err = m.jam.UpdateTeam(tm); err != nil {
 				page.session.setFlashMessage("Error adding team member: "+err.Error(), "error")
 			} else {
-				page.session.setFlashMessage(m.Name+" added to team!", "success")
+				page.session.setFlashMessage(mbr.Name+" added to team!", "success")
+			}
+			redirect("/team/"+tm.UUID+"#members", w, req)
+
+		case "memberpublic":
+			// Toggle whether the member is listed on the public archive
+			mbr, err := tm.GetTeamMemberById(req.FormValue("memberid"))
+			if err == nil {
+				mbr.Public = !mbr.Public
+				err = m.jam.UpdateTeam(tm)
+			}
+			if err != nil {
+				page.session.setFlashMessage("Error updating team member: "+err.Error(), "error")
 			}
 			redirect("/team/"+tm.UUID+"#members", w, req)
 
 		case "deletemember":
 			mbrId := req.FormValue("memberid")
 			err := tm.RemoveTeamMemberById(mbrId)
+			if err == nil {
+				err = m.jam.UpdateTeam(tm)
+			}
 			if err != nil {
 				page.session.setFlashMessage("Error deleting team member: "+err.Error(), "error")
 			} else {
@@ -268,14 +404,19 @@ func handleTeamMgmtRequest(w http.ResponseWriter, req *http.Request) {
 			tm.Game.Link = req.FormValue("gamelink")
 			tm.Game.Description = req.FormValue("gamedesc")
 			tm.Game.Framework = req.FormValue("gameframework")
-			page.session.setFlashMessage("Team game updated", "success")
+			if err := m.jam.UpdateGame(tm.UUID, tm.Game); err != nil {
+				page.session.setFlashMessage("Error updating game: "+err.Error(), "error")
+			} else {
+				page.session.setFlashMessage("Team game updated", "success")
+			}
 			redirect("/team/"+tm.UUID, w, req)
 
 		case "screenshotupload":
-			ss, err := ssFromRequest(tm, req)
+			ss, err := ssFromRequest(w, tm, req)
 			if err != nil {
-				page.session.setFlashMessage("Error updating game: "+err.Error(), "error")
+				page.session.setFlashMessage("Error uploading screenshot: "+err.Error(), "error")
 				redirect("/team/"+tm.UUID, w, req)
+				break
 			}
 			gm := tm.Game
 			gm.Screenshots = append(gm.Screenshots, *ss)
@@ -288,7 +429,11 @@ func handleTeamMgmtRequest(w http.ResponseWriter, req *http.Request) {
 
 		case "screenshotdelete":
 			ssid := vars["subid"]
-			if err := tm.Game.RemoveScreenshot(ssid); err != nil {
+			err := tm.Game.RemoveScreenshot(ssid)
+			if err == nil {
+				err = m.jam.UpdateGame(tm.UUID, tm.Game)
+			}
+			if err != nil {
 				page.session.setFlashMessage("Error deleting screenshot: "+err.Error(), "error")
 			}
 			redirect("/team/"+tm.UUID, w, req)